@@ -65,6 +65,27 @@ type AdGroupLabel struct {
 
 type AdGroupLabelOperations map[string][]AdGroupLabel
 
+// AdGroupFields lists the fields of the ad groups the AdGroupService selects
+// and filters on, see FieldCatalog.
+var AdGroupFields = FieldCatalog{
+	Service: "AdGroupService",
+	Selectable: []string{
+		"Id", "CampaignId", "CampaignName", "Name", "Status", "Settings", "Labels",
+		"ContentBidCriterionTypeGroup", "TrackingUrlTemplate", "FinalUrlSuffix",
+		"UrlCustomParameters", "AdGroupType", "AdRotationMode", "BaseAdGroupId", "BaseCampaignId",
+		"BiddingStrategyId", "BiddingStrategyName", "BiddingStrategyType", "BiddingStrategySource",
+		"CpcBid", "CpmBid", "CpvBid", "TargetCpa", "TargetCpaBid", "TargetCpaBidSource",
+		"EnhancedCpcEnabled",
+	},
+	Filterable: []string{
+		"Id", "CampaignId", "CampaignName", "Name", "Status", "Labels",
+		"ContentBidCriterionTypeGroup", "TrackingUrlTemplate", "FinalUrlSuffix", "AdGroupType",
+		"AdRotationMode", "BaseAdGroupId", "BaseCampaignId", "BiddingStrategyId",
+		"BiddingStrategyName", "BiddingStrategyType", "BiddingStrategySource", "CpcBid", "CpmBid",
+		"CpvBid", "TargetCpa", "TargetCpaBid", "TargetCpaBidSource", "EnhancedCpcEnabled",
+	},
+}
+
 // Get returns an array of ad group's and the total number of ad group's matching
 // the selector.
 //
@@ -103,6 +124,9 @@ type AdGroupLabelOperations map[string][]AdGroupLabel
 //     https://developers.google.com/adwords/api/docs/reference/v201806/AdGroupService#get
 //
 func (s *AdGroupService) Get(selector Selector) (adGroups []AdGroup, totalCount int64, err error) {
+	if err := AdGroupFields.Validate(selector); err != nil {
+		return adGroups, totalCount, err
+	}
 	selector.XMLName = xml.Name{"", "serviceSelector"}
 	respBody, err := s.Auth.request(
 		adGroupServiceUrl,
@@ -112,7 +136,7 @@ func (s *AdGroupService) Get(selector Selector) (adGroups []AdGroup, totalCount
 			Sel     Selector
 		}{
 			XMLName: xml.Name{
-				Space: baseUrl,
+				Space: s.Auth.namespace(adGroupServiceUrl),
 				Local: "get",
 			},
 			Sel: selector,
@@ -132,6 +156,11 @@ func (s *AdGroupService) Get(selector Selector) (adGroups []AdGroup, totalCount
 	return getResp.AdGroups, getResp.Size, err
 }
 
+// All returns a Pager over all the ad groups matching selector.
+func (s *AdGroupService) All(selector Selector) *Pager[AdGroup] {
+	return NewPager(s.Get, selector)
+}
+
 // Mutate allows you to add, modify and remove ad group's, returning the
 // modified ad group's.
 //
@@ -197,7 +226,7 @@ func (s *AdGroupService) Mutate(adGroupOperations AdGroupOperations) (adGroups [
 		Ops     []adGroupOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
-			Space: baseUrl,
+			Space: s.Auth.namespace(adGroupServiceUrl),
 			Local: "mutate",
 		},
 		Ops: operations,
@@ -262,7 +291,7 @@ func (s *AdGroupService) MutateLabel(adGroupLabelOperations AdGroupLabelOperatio
 		Ops     []adGroupLabelOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
-			Space: baseUrl,
+			Space: s.Auth.namespace(adGroupServiceUrl),
 			Local: "mutateLabel",
 		},
 		Ops: operations}
@@ -286,12 +315,35 @@ func (s *AdGroupService) MutateLabel(adGroupLabelOperations AdGroupLabelOperatio
 	return mutateResp.AdGroupLabels, err
 }
 
-// Query is not yet implemented
+// Query returns the ad groups matching the awql query, and their
+// total count regardless of the LIMIT clause.
 //
 // Relevant documentation
 //
 //     https://developers.google.com/adwords/api/docs/reference/v201806/AdGroupService#query
 //
-func (s *AdGroupService) Query(query string) (adGroups []AdGroup, err error) {
-	return adGroups, ERROR_NOT_YET_IMPLEMENTED
+func (s *AdGroupService) Query(query string) (adGroups []AdGroup, totalCount int64, err error) {
+	respBody, err := s.Auth.request(
+		adGroupServiceUrl,
+		"query",
+		AWQLQuery{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(adGroupServiceUrl),
+				Local: "query",
+			},
+			Query: query,
+		},
+	)
+	if err != nil {
+		return adGroups, totalCount, err
+	}
+	queryResp := struct {
+		Size     int64     `xml:"rval>totalNumEntries"`
+		AdGroups []AdGroup `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &queryResp)
+	if err != nil {
+		return adGroups, totalCount, err
+	}
+	return queryResp.AdGroups, queryResp.Size, err
 }
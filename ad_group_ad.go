@@ -66,6 +66,30 @@ type AdGroupAdLabel struct {
 
 type AdGroupAdLabelOperations map[string][]AdGroupAdLabel
 
+// AdGroupAdFields lists the fields of the ads the AdGroupAdService selects
+// and filters on, see FieldCatalog.
+var AdGroupAdFields = FieldCatalog{
+	Service: "AdGroupAdService",
+	Selectable: []string{
+		"AdGroupId", "Id", "AdType", "Url", "DisplayUrl", "CreativeFinalUrls",
+		"CreativeFinalMobileUrls", "CreativeFinalAppUrls", "CreativeTrackingUrlTemplate",
+		"CreativeFinalUrlSuffix", "CreativeUrlCustomParameters", "DevicePreference", "Status",
+		"AdGroupCreativeApprovalStatus", "AdGroupAdDisapprovalReasons",
+		"AdGroupAdTrademarkDisapproved", "Labels", "BaseAdGroupId", "BaseCampaignId",
+		"PolicySummary", "Headline", "Description1", "Description2", "ImageCreativeName",
+		"HeadlinePart1", "HeadlinePart2", "ExpandedTextAdHeadlinePart3", "Description",
+		"ExpandedTextAdDescription2", "Path1", "Path2",
+	},
+	Filterable: []string{
+		"AdGroupId", "Id", "AdType", "Url", "DisplayUrl", "CreativeFinalUrls",
+		"CreativeFinalMobileUrls", "CreativeFinalAppUrls", "CreativeTrackingUrlTemplate",
+		"CreativeFinalUrlSuffix", "CreativeUrlCustomParameters", "DevicePreference", "Status",
+		"AdGroupCreativeApprovalStatus", "AdGroupAdDisapprovalReasons", "Labels", "BaseAdGroupId",
+		"BaseCampaignId", "Headline", "Description1", "Description2", "ImageCreativeName",
+		"HeadlinePart1", "HeadlinePart2", "Description", "Path1", "Path2",
+	},
+}
+
 // Get returns an array of ad's and the total number of ad's matching
 // the selector.
 //
@@ -115,6 +139,9 @@ type AdGroupAdLabelOperations map[string][]AdGroupAdLabel
 //     https://developers.google.com/adwords/api/docs/reference/v201806/AdGroupAdService#get
 //
 func (s AdGroupAdService) Get(selector Selector) (adGroupAds AdGroupAds, totalCount int64, err error) {
+	if err := AdGroupAdFields.Validate(selector); err != nil {
+		return adGroupAds, totalCount, err
+	}
 	selector.XMLName = xml.Name{"", "serviceSelector"}
 	respBody, err := s.Auth.request(
 		adGroupAdServiceUrl,
@@ -124,7 +151,7 @@ func (s AdGroupAdService) Get(selector Selector) (adGroupAds AdGroupAds, totalCo
 			Sel     Selector
 		}{
 			XMLName: xml.Name{
-				Space: baseUrl,
+				Space: s.Auth.namespace(adGroupAdServiceUrl),
 				Local: "get",
 			},
 			Sel: selector,
@@ -144,6 +171,11 @@ func (s AdGroupAdService) Get(selector Selector) (adGroupAds AdGroupAds, totalCo
 	return getResp.AdGroupAds, getResp.Size, err
 }
 
+// All returns a Pager over all the ads matching selector.
+func (s AdGroupAdService) All(selector Selector) *Pager[AdGroupAd] {
+	return NewPager(s.Get, selector)
+}
+
 // Mutate allows you to add, modify and remove ads, returning the
 // modified ads.
 //
@@ -196,7 +228,7 @@ func (s *AdGroupAdService) Mutate(adGroupAdOperations AdGroupAdOperations) (adGr
 		Ops     []adGroupAdOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
-			Space: baseUrl,
+			Space: s.Auth.namespace(adGroupAdServiceUrl),
 			Local: "mutate",
 		},
 		Ops: operations,
@@ -261,7 +293,7 @@ func (s *AdGroupAdService) MutateLabel(adGroupAdLabelOperations AdGroupAdLabelOp
 		Ops     []adGroupAdLabelOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
-			Space: baseUrl,
+			Space: s.Auth.namespace(adGroupAdServiceUrl),
 			Local: "mutateLabel",
 		},
 		Ops: operations}
@@ -280,12 +312,35 @@ func (s *AdGroupAdService) MutateLabel(adGroupAdLabelOperations AdGroupAdLabelOp
 	return mutateResp.AdGroupAdLabels, err
 }
 
-// Query is not yet implemented
+// Query returns the ads matching the awql query, and their
+// total count regardless of the LIMIT clause.
 //
 // Relevant documentation
 //
 //     https://developers.google.com/adwords/api/docs/reference/v201806/AdGroupAdService#query
 //
 func (s *AdGroupAdService) Query(query string) (adGroupAds AdGroupAds, totalCount int64, err error) {
-	return adGroupAds, totalCount, ERROR_NOT_YET_IMPLEMENTED
+	respBody, err := s.Auth.request(
+		adGroupAdServiceUrl,
+		"query",
+		AWQLQuery{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(adGroupAdServiceUrl),
+				Local: "query",
+			},
+			Query: query,
+		},
+	)
+	if err != nil {
+		return adGroupAds, totalCount, err
+	}
+	queryResp := struct {
+		Size       int64      `xml:"rval>totalNumEntries"`
+		AdGroupAds AdGroupAds `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &queryResp)
+	if err != nil {
+		return adGroupAds, totalCount, err
+	}
+	return queryResp.AdGroupAds, queryResp.Size, err
 }
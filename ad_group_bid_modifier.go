@@ -59,8 +59,25 @@ func (cc *AdGroupBidModifier) UnmarshalXML(dec *xml.Decoder, start xml.StartElem
 	return nil
 }
 
+// AdGroupBidModifierFields lists the fields of the ad group bid modifiers
+// the AdGroupBidModifierService selects and filters on, see FieldCatalog.
+var AdGroupBidModifierFields = FieldCatalog{
+	Service: "AdGroupBidModifierService",
+	Selectable: []string{
+		"CampaignId", "AdGroupId", "BaseAdGroupId", "Id", "CriteriaType", "BidModifier",
+		"BidModifierSource", "PlatformName",
+	},
+	Filterable: []string{
+		"CampaignId", "AdGroupId", "BaseAdGroupId", "Id", "CriteriaType", "BidModifier",
+		"BidModifierSource", "PlatformName",
+	},
+}
+
 // Get returns budgets matching a given selector and the total count of matching budgets.
 func (s *AdGroupBidModifierService) Get(selector Selector) (bm []AdGroupBidModifier, totalCount int64, err error) {
+	if err := AdGroupBidModifierFields.Validate(selector); err != nil {
+		return bm, totalCount, err
+	}
 	selector.XMLName = xml.Name{"", "selector"}
 	respBody, err := s.Auth.request(
 		adGroupBidModifierServiceUrl,
@@ -70,7 +87,7 @@ func (s *AdGroupBidModifierService) Get(selector Selector) (bm []AdGroupBidModif
 			Sel     Selector
 		}{
 			XMLName: xml.Name{
-				Space: baseUrl,
+				Space: s.Auth.namespace(adGroupBidModifierServiceUrl),
 				Local: "get",
 			},
 			Sel: selector,
@@ -90,6 +107,11 @@ func (s *AdGroupBidModifierService) Get(selector Selector) (bm []AdGroupBidModif
 	return getResp.AdGroupBidModifiers, getResp.Size, err
 }
 
+// All returns a Pager over all the bid modifiers matching selector.
+func (s *AdGroupBidModifierService) All(selector Selector) *Pager[AdGroupBidModifier] {
+	return NewPager(s.Get, selector)
+}
+
 // Mutate takes a budgetOperations and creates, modifies or destroys the associated budgets.
 func (s *AdGroupBidModifierService) Mutate(bidmOperations AdGroupBidModifierOperations) (resp []AdGroupBidModifier, err error) {
 	type bidmOperation struct {
@@ -110,7 +132,7 @@ func (s *AdGroupBidModifierService) Mutate(bidmOperations AdGroupBidModifierOper
 			Ops     []bidmOperation `xml:"operations"`
 		}{
 			XMLName: xml.Name{
-				Space: baseUrl,
+				Space: s.Auth.namespace(adGroupBidModifierServiceUrl),
 				Local: "mutate",
 			},
 			Ops: operations,
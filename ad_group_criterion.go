@@ -66,6 +66,34 @@ func (agcs *AdGroupCriterions) UnmarshalXML(dec *xml.Decoder, start xml.StartEle
 
 type AdGroupCriterionOperations map[string]AdGroupCriterions
 
+// AdGroupCriterionFields lists the fields of the ad group criteria the
+// AdGroupCriterionService selects and filters on, see FieldCatalog.
+var AdGroupCriterionFields = FieldCatalog{
+	Service: "AdGroupCriterionService",
+	Selectable: []string{
+		"AdGroupId", "CriterionUse", "Id", "CriteriaType", "Labels", "Status", "SystemServingStatus",
+		"ApprovalStatus", "DisapprovalReasons", "QualityScore", "BidModifier", "BaseAdGroupId",
+		"BaseCampaignId", "BiddingStrategyId", "BiddingStrategyName", "BiddingStrategyType",
+		"BiddingStrategySource", "CpcBid", "CpcBidSource", "CpmBid", "CpmBidSource", "FirstPageCpc",
+		"TopOfPageCpc", "FirstPositionCpc", "FinalUrls", "FinalMobileUrls", "FinalAppUrls",
+		"FinalUrlSuffix", "TrackingUrlTemplate", "UrlCustomParameters", "AgeRangeType",
+		"AppPaymentModelType", "UserInterestId", "UserInterestName", "UserListId", "UserListName",
+		"UserListMembershipStatus", "GenderType", "KeywordText", "KeywordMatchType",
+		"MobileAppCategoryId", "DisplayName", "PlacementUrl", "Text", "PartitionType",
+		"ParentCriterionId", "CaseValue", "VerticalId", "VerticalParentId", "Path", "Parameter",
+		"CriteriaCoverage", "CriteriaSamples",
+	},
+	Filterable: []string{
+		"AdGroupId", "CriterionUse", "Id", "CriteriaType", "Labels", "Status", "SystemServingStatus",
+		"ApprovalStatus", "QualityScore", "BidModifier", "BaseAdGroupId", "BaseCampaignId",
+		"BiddingStrategyId", "BiddingStrategyName", "BiddingStrategyType", "BiddingStrategySource",
+		"CpcBid", "CpcBidSource", "CpmBid", "CpmBidSource", "FirstPageCpc", "TopOfPageCpc",
+		"FirstPositionCpc", "FinalUrls", "FinalMobileUrls", "FinalAppUrls", "FinalUrlSuffix",
+		"TrackingUrlTemplate", "UserListMembershipStatus", "KeywordText", "KeywordMatchType",
+		"DisplayName", "PlacementUrl",
+	},
+}
+
 // Get returns an array of AdGroupCriterion's and the total number of AdGroupCriterion's matching
 // the selector.
 //
@@ -143,6 +171,9 @@ type AdGroupCriterionOperations map[string]AdGroupCriterions
 //     https://developers.google.com/adwords/api/docs/reference/v201806/AdGroupCriterionService#get
 //
 func (s AdGroupCriterionService) Get(selector Selector) (adGroupCriterions AdGroupCriterions, totalCount int64, err error) {
+	if err := AdGroupCriterionFields.Validate(selector); err != nil {
+		return adGroupCriterions, totalCount, err
+	}
 	selector.XMLName = xml.Name{"", "serviceSelector"}
 	respBody, err := s.Auth.request(
 		adGroupCriterionServiceUrl,
@@ -152,7 +183,7 @@ func (s AdGroupCriterionService) Get(selector Selector) (adGroupCriterions AdGro
 			Sel     Selector
 		}{
 			XMLName: xml.Name{
-				Space: baseUrl,
+				Space: s.Auth.namespace(adGroupCriterionServiceUrl),
 				Local: "get",
 			},
 			Sel: selector,
@@ -172,6 +203,11 @@ func (s AdGroupCriterionService) Get(selector Selector) (adGroupCriterions AdGro
 	return getResp.AdGroupCriterions, getResp.Size, err
 }
 
+// All returns a Pager over all the ad group criteria matching selector.
+func (s AdGroupCriterionService) All(selector Selector) *Pager[interface{}] {
+	return NewPager(s.Get, selector)
+}
+
 // Mutate allows you to add, modify and remove ad group criterion, returning the
 // modified ad group criterion.
 //
@@ -242,7 +278,7 @@ func (s *AdGroupCriterionService) Mutate(adGroupCriterionOperations AdGroupCrite
 		Ops     []adGroupCriterionOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
-			Space: baseUrl,
+			Space: s.Auth.namespace(adGroupCriterionServiceUrl),
 			Local: "mutate",
 		},
 		Ops: operations,
@@ -308,7 +344,7 @@ func (s *AdGroupCriterionService) MutateLabel(adGroupCriterionLabelOperations Ad
 		Ops     []adGroupCriterionLabelOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
-			Space: baseUrl,
+			Space: s.Auth.namespace(adGroupCriterionServiceUrl),
 			Local: "mutateLabel",
 		},
 		Ops: operations}
@@ -332,12 +368,35 @@ func (s *AdGroupCriterionService) MutateLabel(adGroupCriterionLabelOperations Ad
 	return mutateResp.AdGroupCriterionLabels, err
 }
 
-// Query is not yet implemented
+// Query returns the ad group criteria matching the awql query, and their
+// total count regardless of the LIMIT clause.
 //
 // Relevant documentation
 //
 //     https://developers.google.com/adwords/api/docs/reference/v201806/AdGroupCriterionService#query
 //
-func (s *AdGroupCriterionService) Query(query string) (adGroupCriterions AdGroupCriterions, err error) {
-	return adGroupCriterions, ERROR_NOT_YET_IMPLEMENTED
+func (s *AdGroupCriterionService) Query(query string) (adGroupCriterions AdGroupCriterions, totalCount int64, err error) {
+	respBody, err := s.Auth.request(
+		adGroupCriterionServiceUrl,
+		"query",
+		AWQLQuery{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(adGroupCriterionServiceUrl),
+				Local: "query",
+			},
+			Query: query,
+		},
+	)
+	if err != nil {
+		return adGroupCriterions, totalCount, err
+	}
+	queryResp := struct {
+		Size              int64             `xml:"rval>totalNumEntries"`
+		AdGroupCriterions AdGroupCriterions `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &queryResp)
+	if err != nil {
+		return adGroupCriterions, totalCount, err
+	}
+	return queryResp.AdGroupCriterions, queryResp.Size, err
 }
@@ -1,8 +1,7 @@
 package gads
 
 import (
-//	"encoding/xml"
-//	"fmt"
+	"encoding/xml"
 )
 
 type AdGroupFeedService struct {
@@ -13,38 +12,194 @@ func NewAdGroupFeedService(auth *Auth) *AdGroupFeedService {
 	return &AdGroupFeedService{Auth: *auth}
 }
 
-type AdGroupFeedOperations struct {
+// AdGroupFeed links a feed to an ad group, the matching function selects
+// the feed items served with its ads.
+//
+// PlaceholderTypes: see the PlaceholderType constants
+// Status: ENABLED, REMOVED
+type AdGroupFeed struct {
+	FeedId           int64     `xml:"feedId"`
+	AdGroupId        int64     `xml:"adGroupId"`
+	MatchingFunction *Function `xml:"matchingFunction,omitempty"`
+	PlaceholderTypes []int64   `xml:"placeholderTypes,omitempty"`
+	Status           string    `xml:"status,omitempty"`
+	BaseCampaignId   int64     `xml:"baseCampaignId,omitempty"`
+	BaseAdGroupId    int64     `xml:"baseAdGroupId,omitempty"`
 }
 
-type AdGroupFeed struct {
+// AdGroupFeedOperations is a map of operations to perform on
+// AdGroupFeed's, ad group feeds can only be added and removed.
+type AdGroupFeedOperations map[string][]AdGroupFeed
+
+// AdGroupFeedFields lists the fields of the ad group feeds the
+// AdGroupFeedService selects and filters on, see FieldCatalog.
+var AdGroupFeedFields = FieldCatalog{
+	Service: "AdGroupFeedService",
+	Selectable: []string{
+		"FeedId", "AdGroupId", "MatchingFunction", "PlaceholderTypes", "Status", "BaseCampaignId",
+		"BaseAdGroupId",
+	},
+	Filterable: []string{
+		"FeedId", "AdGroupId", "PlaceholderTypes", "Status", "BaseCampaignId", "BaseAdGroupId",
+	},
 }
 
-// Get is not yet implemented
+// Get returns an array of AdGroupFeed's and the total number of
+// AdGroupFeed's matching the selector.
+//
+// Example
+//
+//   adGroupFeeds, totalCount, err := adGroupFeedService.Get(
+//     gads.Selector{
+//       Fields: []string{"FeedId", "AdGroupId", "MatchingFunction", "PlaceholderTypes"},
+//       Predicates: []gads.Predicate{
+//         {"AdGroupId", "IN", adGroupIds},
+//       },
+//     },
+//   )
 //
 // Relevant documentation
 //
 //     https://developers.google.com/adwords/api/docs/reference/v201806/AdGroupFeedService#get
 //
-func (s AdGroupFeedService) Get(selector Selector) (adGroupFeeds []AdGroupFeed, err error) {
-	return adGroupFeeds, ERROR_NOT_YET_IMPLEMENTED
+func (s *AdGroupFeedService) Get(selector Selector) (adGroupFeeds []AdGroupFeed, totalCount int64, err error) {
+	if err := AdGroupFeedFields.Validate(selector); err != nil {
+		return adGroupFeeds, totalCount, err
+	}
+	selector.XMLName = xml.Name{"", "selector"}
+	respBody, err := s.Auth.request(
+		adGroupFeedServiceUrl,
+		"get",
+		struct {
+			XMLName xml.Name
+			Sel     Selector
+		}{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(adGroupFeedServiceUrl),
+				Local: "get",
+			},
+			Sel: selector,
+		},
+	)
+	if err != nil {
+		return adGroupFeeds, totalCount, err
+	}
+	getResp := struct {
+		Size         int64         `xml:"rval>totalNumEntries"`
+		AdGroupFeeds []AdGroupFeed `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return adGroupFeeds, totalCount, err
+	}
+	return getResp.AdGroupFeeds, getResp.Size, err
 }
 
-// Mutate is not yet implemented
+// All returns a Pager over all the ad group feeds matching selector.
+func (s *AdGroupFeedService) All(selector Selector) *Pager[AdGroupFeed] {
+	return NewPager(s.Get, selector)
+}
+
+// Mutate allows you to link feeds to ad groups and unlink them, returning
+// the modified ad group feeds.
+//
+// Example
+//
+//   adGroupFeeds, err := adGroupFeedService.Mutate(
+//     gads.AdGroupFeedOperations{
+//       "ADD": {
+//         gads.AdGroupFeed{
+//           FeedId:           feed.Id,
+//           AdGroupId:        adGroup.Id,
+//           PlaceholderTypes: []int64{gads.PlaceholderTypeCallout},
+//           MatchingFunction: &gads.Function{
+//             Operator:   "IDENTITY",
+//             LhsOperand: gads.FunctionArgumentOperands{gads.NewBooleanConstant(true)},
+//           },
+//         },
+//       },
+//     },
+//   )
 //
 // Relevant documentation
 //
 //     https://developers.google.com/adwords/api/docs/reference/v201806/AdGroupFeedService#mutate
 //
 func (s *AdGroupFeedService) Mutate(adGroupFeedOperations AdGroupFeedOperations) (adGroupFeeds []AdGroupFeed, err error) {
-	return adGroupFeeds, ERROR_NOT_YET_IMPLEMENTED
+	type adGroupFeedOperation struct {
+		Action      string      `xml:"operator"`
+		AdGroupFeed AdGroupFeed `xml:"operand"`
+	}
+	operations := []adGroupFeedOperation{}
+	for action, adGroupFeeds := range adGroupFeedOperations {
+		for _, adGroupFeed := range adGroupFeeds {
+			operations = append(operations,
+				adGroupFeedOperation{
+					Action:      action,
+					AdGroupFeed: adGroupFeed,
+				},
+			)
+		}
+	}
+	mutation := struct {
+		XMLName xml.Name
+		Ops     []adGroupFeedOperation `xml:"operations"`
+	}{
+		XMLName: xml.Name{
+			Space: s.Auth.namespace(adGroupFeedServiceUrl),
+			Local: "mutate",
+		},
+		Ops: operations,
+	}
+	respBody, err := s.Auth.request(adGroupFeedServiceUrl, "mutate", mutation)
+	if err != nil {
+		return adGroupFeeds, err
+	}
+	mutateResp := struct {
+		BaseResponse
+		AdGroupFeeds []AdGroupFeed `xml:"rval>value"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &mutateResp)
+	if err != nil {
+		return adGroupFeeds, err
+	}
+
+	if len(mutateResp.PartialFailureErrors) > 0 {
+		err = mutateResp.PartialFailureErrors
+	}
+
+	return mutateResp.AdGroupFeeds, err
 }
 
-// Query is not yet implemented
+// Query returns the ad group feeds matching the awql query, and their
+// total count regardless of the LIMIT clause.
 //
 // Relevant documentation
 //
 //     https://developers.google.com/adwords/api/docs/reference/v201806/AdGroupFeedService#query
 //
-func (s *AdGroupFeedService) Query(query string) (adGroupFeeds []AdGroupFeed, err error) {
-	return adGroupFeeds, ERROR_NOT_YET_IMPLEMENTED
+func (s *AdGroupFeedService) Query(query string) (adGroupFeeds []AdGroupFeed, totalCount int64, err error) {
+	respBody, err := s.Auth.request(
+		adGroupFeedServiceUrl,
+		"query",
+		AWQLQuery{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(adGroupFeedServiceUrl),
+				Local: "query",
+			},
+			Query: query,
+		},
+	)
+	if err != nil {
+		return adGroupFeeds, totalCount, err
+	}
+	queryResp := struct {
+		Size         int64         `xml:"rval>totalNumEntries"`
+		AdGroupFeeds []AdGroupFeed `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &queryResp)
+	if err != nil {
+		return adGroupFeeds, totalCount, err
+	}
+	return queryResp.AdGroupFeeds, queryResp.Size, err
 }
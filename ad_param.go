@@ -1,27 +1,237 @@
 package gads
 
 import (
-//	"encoding/xml"
-//	"fmt"
+	"encoding/xml"
+	"fmt"
+	"unicode/utf8"
 )
 
 type AdParamService struct {
 	Auth
 }
 
+func NewAdParamService(auth *Auth) *AdParamService {
+	return &AdParamService{Auth: *auth}
+}
+
+// MaxAdParamsPerRequest is the number of operations the api accepts in a
+// single mutate.
+const MaxAdParamsPerRequest = 5000
+
+// AdParam is the text replacing the {param1:default} or {param2:default}
+// placeholders of the text ads of an ad group when they are served for the
+// keyword.
+//
+// ParamIndex: 1 or 2
+// InsertionText: up to 25 characters, digits with optional currency
+// symbols, separators and a percent sign, such as "$1,299.99" or "20%"
 type AdParam struct {
+	AdGroupId     int64  `xml:"adGroupId"`
+	CriterionId   int64  `xml:"criterionId"`
+	InsertionText string `xml:"insertionText,omitempty"`
+	ParamIndex    int    `xml:"paramIndex"`
 }
 
-func NewAdParamService(auth *Auth) *AdParamService {
-	return &AdParamService{Auth: *auth}
+// validate checks the index and the length of the insertion text, its
+// content is left to the api.
+func (p AdParam) validate() error {
+	if p.ParamIndex != 1 && p.ParamIndex != 2 {
+		return fmt.Errorf("invalid param index %d, expected 1 or 2", p.ParamIndex)
+	}
+	if n := utf8.RuneCountInString(p.InsertionText); n == 0 || n > 25 {
+		return fmt.Errorf("invalid insertion text %q, expected 1 to 25 characters", p.InsertionText)
+	}
+	return nil
+}
+
+// AdParamOperations is a map of operations to perform on AdParam's, ad
+// params can be set and removed.
+type AdParamOperations map[string][]AdParam
+
+// AdParamFields lists the fields of the ad params the AdParamService
+// selects and filters on, see FieldCatalog.
+var AdParamFields = FieldCatalog{
+	Service:    "AdParamService",
+	Selectable: []string{"AdGroupId", "CriterionId", "InsertionText", "ParamIndex"},
+	Filterable: []string{"AdGroupId", "CriterionId"},
 }
 
-// Query is not yet implemented
+// Get returns an array of AdParam's and the total number of AdParam's
+// matching the selector, which must filter on AdGroupId.
+//
+// Example
+//
+//   adParams, totalCount, err := adParamService.Get(
+//     gads.Selector{
+//       Fields: gads.AdParamFields.Selectable,
+//       Predicates: []gads.Predicate{
+//         {"AdGroupId", "IN", []string{"432434", "9282881"}},
+//       },
+//     },
+//   )
 //
 // Relevant documentation
 //
 //     https://developers.google.com/adwords/api/docs/reference/v201806/AdParamService#get
 //
-func (s AdParamService) Get(selector Selector) (adParams []AdParam, err error) {
-	return adParams, ERROR_NOT_YET_IMPLEMENTED
+func (s *AdParamService) Get(selector Selector) (adParams []AdParam, totalCount int64, err error) {
+	if err := AdParamFields.Validate(selector); err != nil {
+		return adParams, totalCount, err
+	}
+	selector.XMLName = xml.Name{"", "serviceSelector"}
+	respBody, err := s.Auth.request(
+		adParamServiceUrl,
+		"get",
+		struct {
+			XMLName xml.Name
+			Sel     Selector
+		}{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(adParamServiceUrl),
+				Local: "get",
+			},
+			Sel: selector,
+		},
+	)
+	if err != nil {
+		return adParams, totalCount, err
+	}
+	getResp := struct {
+		Size     int64     `xml:"rval>totalNumEntries"`
+		AdParams []AdParam `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return adParams, totalCount, err
+	}
+	return getResp.AdParams, getResp.Size, err
+}
+
+// All returns a Pager over all the ad params matching selector.
+func (s *AdParamService) All(selector Selector) *Pager[AdParam] {
+	return NewPager(s.Get, selector)
+}
+
+// Mutate allows you to set and remove ad params, returning the modified
+// ad params.
+//
+// Example
+//
+//   adParams, err := adParamService.Mutate(
+//     gads.AdParamOperations{
+//       "SET": {
+//         gads.AdParam{AdGroupId: 432434, CriterionId: 3840003, ParamIndex: 1, InsertionText: "$1,299.99"},
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/AdParamService#mutate
+//
+func (s *AdParamService) Mutate(adParamOperations AdParamOperations) (adParams []AdParam, err error) {
+	type adParamOperation struct {
+		Action  string  `xml:"operator"`
+		AdParam AdParam `xml:"operand"`
+	}
+	operations := []adParamOperation{}
+	for action, adParams := range adParamOperations {
+		for _, adParam := range adParams {
+			operations = append(operations,
+				adParamOperation{
+					Action:  action,
+					AdParam: adParam,
+				},
+			)
+		}
+	}
+	mutation := struct {
+		XMLName xml.Name
+		Ops     []adParamOperation `xml:"operations"`
+	}{
+		XMLName: xml.Name{
+			Space: s.Auth.namespace(adParamServiceUrl),
+			Local: "mutate",
+		},
+		Ops: operations,
+	}
+	respBody, err := s.Auth.request(adParamServiceUrl, "mutate", mutation)
+	if err != nil {
+		return adParams, err
+	}
+	mutateResp := struct {
+		BaseResponse
+		AdParams []AdParam `xml:"rval>value"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &mutateResp)
+	if err != nil {
+		return adParams, err
+	}
+
+	if len(mutateResp.PartialFailureErrors) > 0 {
+		err = mutateResp.PartialFailureErrors
+	}
+
+	return mutateResp.AdParams, err
+}
+
+// AdParamError is an ad param rejected by Update, before the upload or by
+// the api.
+type AdParamError struct {
+	AdParam AdParam
+	Err     error
+}
+
+func (e AdParamError) Error() string {
+	return fmt.Sprintf("ad param %d of criterion %d in ad group %d: %s", e.AdParam.ParamIndex, e.AdParam.CriterionId, e.AdParam.AdGroupId, e.Err)
+}
+
+// Update sets the ad params in batches of batchSize,
+// MaxAdParamsPerRequest if zero, such as the prices of thousands of
+// keywords refreshed from a catalog.
+//
+// An ad param with an index other than 1 or 2, or an insertion text empty
+// or longer than 25 characters, is rejected without being sent. One the
+// api refuses, such as the ad param of a removed keyword, is rejected
+// alone, the other ad params of its request are still set. err is the
+// failure of a whole request, the update stops there.
+//
+// Example
+//
+//   adParams := []gads.AdParam{}
+//   for _, product := range products {
+//     adParams = append(adParams, gads.AdParam{
+//       AdGroupId:     product.AdGroupId,
+//       CriterionId:   product.KeywordId,
+//       ParamIndex:    1,
+//       InsertionText: fmt.Sprintf("$%.2f", product.Price),
+//     })
+//   }
+//   updated, rejected, err := adParamService.Update(adParams, 0)
+//
+func (s *AdParamService) Update(adParams []AdParam, batchSize int) (updated int, rejected []AdParamError, err error) {
+	if batchSize <= 0 || batchSize > MaxAdParamsPerRequest {
+		batchSize = MaxAdParamsPerRequest
+	}
+	valid := []AdParam{}
+	for _, adParam := range adParams {
+		if err := adParam.validate(); err != nil {
+			rejected = append(rejected, AdParamError{AdParam: adParam, Err: err})
+			continue
+		}
+		valid = append(valid, adParam)
+	}
+
+	service := *s
+	service.Auth.PartialFailure = true
+	updated, err = mutateInBatches(len(valid), batchSize,
+		func(start, end int) error {
+			_, err := service.Mutate(AdParamOperations{"SET": valid[start:end]})
+			return err
+		},
+		func(index int, err error) {
+			rejected = append(rejected, AdParamError{AdParam: valid[index], Err: err})
+		},
+	)
+	return updated, rejected, err
 }
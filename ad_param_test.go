@@ -0,0 +1,68 @@
+package gads
+
+import (
+	"strings"
+	"testing"
+)
+
+const testAdParamPartialFailure = `
+        <partialFailureErrors xsi:type="AdParamError">
+          <fieldPath>operations[1].operand.insertionText</fieldPath>
+          <trigger>abc</trigger>
+          <errorString>AdParamError.INVALID_INSERTION_TEXT</errorString>
+          <reason>INVALID_INSERTION_TEXT</reason>
+        </partialFailureErrors>
+        <value><adGroupId>1</adGroupId><criterionId>10</criterionId><insertionText>$9.99</insertionText><paramIndex>1</paramIndex></value>
+        <value/>`
+
+func TestAdParamUpdate(t *testing.T) {
+	var requests []testRequest
+	auth, cleanup := testServiceAuth(t, &requests, func(request testRequest) string {
+		if len(requests) == 1 {
+			return testAdParamPartialFailure
+		}
+		return "<value/>"
+	})
+	defer cleanup()
+
+	adParams := []AdParam{
+		{AdGroupId: 1, CriterionId: 10, ParamIndex: 1, InsertionText: "$9.99"},
+		{AdGroupId: 1, CriterionId: 11, ParamIndex: 1, InsertionText: "abc"},
+		{AdGroupId: 1, CriterionId: 12, ParamIndex: 3, InsertionText: "$1"},
+		{AdGroupId: 1, CriterionId: 13, ParamIndex: 2, InsertionText: "20%"},
+	}
+	service := NewAdParamService(&auth)
+	updated, rejected, err := service.Update(adParams, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated != 2 {
+		t.Errorf("expected 2 ad params updated, got %d", updated)
+	}
+	if len(rejected) != 2 || rejected[0].AdParam.CriterionId != 12 || rejected[1].AdParam.CriterionId != 11 {
+		t.Fatalf("unexpected rejected ad params %v", rejected)
+	}
+	if !strings.Contains(rejected[1].Error(), "INVALID_INSERTION_TEXT") {
+		t.Errorf("expected the api error to be reported, got %s", rejected[1])
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 batches, got %d", len(requests))
+	}
+	for _, expected := range []string{
+		"<partialFailure>true</partialFailure>",
+		"<operator>SET</operator>",
+		"<criterionId>11</criterionId>",
+		"<insertionText>$9.99</insertionText>",
+	} {
+		if !strings.Contains(requests[0].Body, expected) {
+			t.Errorf("expected %s in the first batch\n%s", expected, requests[0].Body)
+		}
+	}
+	if !strings.Contains(requests[1].Body, "<paramIndex>2</paramIndex>") || strings.Contains(requests[1].Body, "<criterionId>12</criterionId>") {
+		t.Errorf("unexpected second batch\n%s", requests[1].Body)
+	}
+	if service.Auth.PartialFailure {
+		t.Error("expected the partial failure to be set for the update only")
+	}
+}
@@ -148,6 +148,22 @@ func NewSimilarUserList(name, description, status, integrationCode string, membe
 	}
 }
 
+// UserListFields lists the fields of the user lists the
+// AdwordsUserListService selects and filters on, see FieldCatalog.
+var UserListFields = FieldCatalog{
+	Service: "AdwordsUserListService",
+	Selectable: []string{
+		"Id", "IsReadOnly", "Name", "Description", "Status", "IntegrationCode", "AccessReason",
+		"AccountUserListStatus", "MembershipLifeSpan", "Size", "SizeRange", "SizeForSearch",
+		"SizeRangeForSearch", "ListType", "ConversionType", "Rules", "SeedUserListId",
+		"SeedUserListName", "SeedUserListDescription", "SeedUserListStatus", "SeedListSize",
+	},
+	Filterable: []string{
+		"Id", "Name", "Status", "IntegrationCode", "AccessReason", "AccountUserListStatus",
+		"MembershipLifeSpan", "Size", "SizeForSearch", "ListType", "SeedUserListId", "SeedListSize",
+	},
+}
+
 // Get returns an array of adwords user lists and the total number of adwords user lists matching
 // the selector.
 //
@@ -193,7 +209,10 @@ func NewSimilarUserList(name, description, status, integrationCode string, membe
 //
 //     https://developers.google.com/adwords/api/docs/reference/v201806/AdwordsUserListService#get
 //
-func (s AdwordsUserListService) Get(selector Selector) (userLists []UserList, err error) {
+func (s *AdwordsUserListService) Get(selector Selector) (userLists []UserList, totalCount int64, err error) {
+	if err := UserListFields.Validate(selector); err != nil {
+		return userLists, totalCount, err
+	}
 	selector.XMLName = xml.Name{"", "serviceSelector"}
 	respBody, err := s.Auth.request(
 		adwordsUserListServiceUrl,
@@ -203,14 +222,14 @@ func (s AdwordsUserListService) Get(selector Selector) (userLists []UserList, er
 			Sel     Selector
 		}{
 			XMLName: xml.Name{
-				Space: rmktgBaseUrl,
+				Space: s.Auth.namespace(adwordsUserListServiceUrl),
 				Local: "get",
 			},
 			Sel: selector,
 		},
 	)
 	if err != nil {
-		return userLists, err
+		return userLists, totalCount, err
 	}
 	getResp := struct {
 		Size      int64      `xml:"rval>totalNumEntries"`
@@ -219,9 +238,14 @@ func (s AdwordsUserListService) Get(selector Selector) (userLists []UserList, er
 
 	err = xml.Unmarshal([]byte(respBody), &getResp)
 	if err != nil {
-		return userLists, err
+		return userLists, totalCount, err
 	}
-	return getResp.UserLists, err
+	return getResp.UserLists, getResp.Size, err
+}
+
+// All returns a Pager over all the user lists matching selector.
+func (s *AdwordsUserListService) All(selector Selector) *Pager[UserList] {
+	return NewPager(s.Get, selector)
 }
 
 // Mutate is not yet implemented
@@ -232,7 +256,7 @@ func (s AdwordsUserListService) Get(selector Selector) (userLists []UserList, er
 //
 func (s *AdwordsUserListService) Mutate(userListOperations UserListOperations) (adwordsUserLists []UserList, err error) {
 	type userListOperation struct {
-		Action   string   `xml:"https://adwords.google.com/api/adwords/cm/v201806 operator"`
+		Action   cmOperator
 		UserList UserList `xml:"operand"`
 	}
 	operations := []userListOperation{}
@@ -241,7 +265,7 @@ func (s *AdwordsUserListService) Mutate(userListOperations UserListOperations) (
 			operations = append(
 				operations,
 				userListOperation{
-					Action:   action,
+					Action:   s.Auth.cmOperator(action),
 					UserList: userList,
 				},
 			)
@@ -252,7 +276,7 @@ func (s *AdwordsUserListService) Mutate(userListOperations UserListOperations) (
 		Ops     []userListOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
-			Space: rmktgBaseUrl,
+			Space: s.Auth.namespace(adwordsUserListServiceUrl),
 			Local: "mutate",
 		},
 		Ops: operations,
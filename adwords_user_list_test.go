@@ -0,0 +1,49 @@
+package gads
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+func TestAdwordsUserListAll(t *testing.T) {
+	startIndex := regexp.MustCompile(`<startIndex>(\d+)</startIndex>`)
+	requests := []testRequest{}
+	auth, cleanup := testServiceAuth(t, &requests, func(request testRequest) string {
+		offset := 0
+		if match := startIndex.FindStringSubmatch(request.Body); match != nil {
+			fmt.Sscan(match[1], &offset)
+		}
+		entries := ""
+		for id := offset; id < offset+2 && id < 3; id++ {
+			entries += fmt.Sprintf(`<entries xsi:type="BasicUserList"><id>%d</id><name>list %d</name><status>OPEN</status></entries>`, id, id)
+		}
+		return "<totalNumEntries>3</totalNumEntries>" + entries
+	})
+	defer cleanup()
+
+	uls := NewAdwordsUserListService(&auth)
+	userLists, totalCount, err := uls.Get(Selector{Fields: []string{"Id", "Name"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if totalCount != 3 || len(userLists) != 2 {
+		t.Errorf("expected 2 of 3 user lists, got %d of %d", len(userLists), totalCount)
+	}
+
+	pager := uls.All(Selector{Fields: []string{"Id", "Name"}})
+	pager.PageSize = 2
+	names := []string{}
+	for pager.Next() {
+		names = append(names, pager.Value().Name)
+	}
+	if err := pager.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(names) != "[list 0 list 1 list 2]" || pager.TotalCount() != 3 {
+		t.Errorf("unexpected user lists %q of %d", names, pager.TotalCount())
+	}
+	if len(requests) != 3 || requests[0].Service != "AdwordsUserListService" {
+		t.Errorf("expected a get and 2 pages of AdwordsUserListService, got %+v", requests)
+	}
+}
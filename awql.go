@@ -60,16 +60,18 @@ const (
 	maxSizeForNotValidBody = 30
 )
 
-// Download downloads a report by awql request
+// Download downloads a report by awql request, its rows can be read with
+// NewReportDecoder
 func (a *AWQLClient) Download(awqlReq AWQLRequest) (io.ReadCloser, error) {
 	req, err := http.NewRequest(
 		"POST",
-		reportAPIURL,
+		a.reportURL(),
 		strings.NewReader(url.Values{"__rdquery": {awqlReq.Query}, "__fmt": {string(awqlReq.Format)}}.Encode()),
 	)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(a.context())
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded; param=value")
 	req.Header.Add("Accept", "*/*")
 	req.Header.Add("developerToken", a.DeveloperToken)
@@ -79,7 +81,7 @@ func (a *AWQLClient) Download(awqlReq AWQLRequest) (io.ReadCloser, error) {
 	req.Header.Add("skipReportSummary", strconv.FormatBool(awqlReq.SkipReportSummary))
 	req.Header.Add("includeZeroImpressions", strconv.FormatBool(awqlReq.IncludeZeroImpressions))
 	req.Header.Add("useRawEnumValues", strconv.FormatBool(awqlReq.UseRawEnumValues))
-	resp, err := a.Client.Do(req)
+	resp, err := a.client().Do(req)
 	if err != nil {
 		return nil, err
 	}
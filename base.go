@@ -2,12 +2,14 @@ package gads
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"flag"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
@@ -15,10 +17,15 @@ import (
 const (
 	// https://developers.google.com/adwords/api/docs/reference/
 	apiVersion         = "v201806"
-	baseUrl            = "https://adwords.google.com/api/adwords/cm/" + apiVersion
-	rmktgBaseUrl       = "https://adwords.google.com/api/adwords/rm/" + apiVersion
-	managedCustomerUrl = "https://adwords.google.com/api/adwords/mcm/" + apiVersion
-	reportAPIURL       = "https://adwords.google.com/api/adwords/reportdownload/" + apiVersion
+	baseUrl            = DefaultEndpoint + "/cm/" + apiVersion
+	rmktgBaseUrl       = DefaultEndpoint + "/rm/" + apiVersion
+	managedCustomerUrl = DefaultEndpoint + "/mcm/" + apiVersion
+	optimizationUrl    = DefaultEndpoint + "/o/" + apiVersion
+	changeHistoryUrl   = DefaultEndpoint + "/ch/" + apiVersion
+	billingUrl         = DefaultEndpoint + "/billing/" + apiVersion
+	// DefaultEndpoint is the production root of the api, used when
+	// Auth.Endpoint is not set
+	DefaultEndpoint = "https://adwords.google.com/api/adwords"
 	// used for developpement, if true all unknown field will raise an error
 	StrictMode = false
 )
@@ -55,7 +62,7 @@ var (
 	adParamServiceUrl                  = ServiceUrl{baseUrl, "AdParamService"}
 	adwordsUserListServiceUrl          = ServiceUrl{rmktgBaseUrl, "AdwordsUserListService"}
 	biddingStrategyServiceUrl          = ServiceUrl{baseUrl, "BiddingStrategyService"}
-	budgetOrderServiceUrl              = ServiceUrl{baseUrl, "BudgetOrderService"}
+	budgetOrderServiceUrl              = ServiceUrl{billingUrl, "BudgetOrderService"}
 	budgetServiceUrl                   = ServiceUrl{baseUrl, "BudgetService"}
 	campaignAdExtensionServiceUrl      = ServiceUrl{baseUrl, "CampaignAdExtensionService"}
 	campaignCriterionServiceUrl        = ServiceUrl{baseUrl, "CampaignCriterionService"}
@@ -67,10 +74,12 @@ var (
 	conversionTrackerServiceUrl        = ServiceUrl{baseUrl, "ConversionTrackerService"}
 	customerFeedServiceUrl             = ServiceUrl{baseUrl, "CustomerFeedService"}
 	customerServiceUrl                 = ServiceUrl{managedCustomerUrl, "CustomerService"}
-	customerSyncServiceUrl             = ServiceUrl{baseUrl, "CustomerSyncService"}
+	customerSyncServiceUrl             = ServiceUrl{changeHistoryUrl, "CustomerSyncService"}
 	dataServiceUrl                     = ServiceUrl{baseUrl, "DataService"}
+	draftServiceUrl                    = ServiceUrl{baseUrl, "DraftService"}
 	experimentServiceUrl               = ServiceUrl{baseUrl, "ExperimentService"}
 	feedItemServiceUrl                 = ServiceUrl{baseUrl, "FeedItemService"}
+	feedItemTargetServiceUrl           = ServiceUrl{baseUrl, "FeedItemTargetService"}
 	feedMappingServiceUrl              = ServiceUrl{baseUrl, "FeedMappingService"}
 	feedServiceUrl                     = ServiceUrl{baseUrl, "FeedService"}
 	geoLocationServiceUrl              = ServiceUrl{baseUrl, "GeoLocationService"}
@@ -78,13 +87,14 @@ var (
 	locationCriterionServiceUrl        = ServiceUrl{baseUrl, "LocationCriterionService"}
 	managedCustomerServiceUrl          = ServiceUrl{managedCustomerUrl, "ManagedCustomerService"}
 	mediaServiceUrl                    = ServiceUrl{baseUrl, "MediaService"}
-	mutateJobServiceUrl                = ServiceUrl{baseUrl, "Mutate_JOB_Service"}
+	batchJobServiceUrl                 = ServiceUrl{baseUrl, "BatchJobService"}
 	offlineConversionFeedServiceUrl    = ServiceUrl{baseUrl, "OfflineConversionFeedService"}
 	reportDefinitionServiceUrl         = ServiceUrl{baseUrl, "ReportDefinitionService"}
 	sharedCriterionServiceUrl          = ServiceUrl{baseUrl, "SharedCriterionService"}
 	sharedSetServiceUrl                = ServiceUrl{baseUrl, "SharedSetService"}
-	targetingIdeaServiceUrl            = ServiceUrl{baseUrl, "TargetingIdeaService"}
-	trafficEstimatorServiceUrl         = ServiceUrl{baseUrl, "TrafficEstimatorService"}
+	targetingIdeaServiceUrl            = ServiceUrl{optimizationUrl, "TargetingIdeaService"}
+	trialServiceUrl                    = ServiceUrl{baseUrl, "TrialService"}
+	trafficEstimatorServiceUrl         = ServiceUrl{optimizationUrl, "TrafficEstimatorService"}
 )
 
 func (s ServiceUrl) String() string {
@@ -95,10 +105,111 @@ type Auth struct {
 	CustomerId     string
 	DeveloperToken string
 	UserAgent      string
-	ValidateOnly   bool         `json:"-"`
-	PartialFailure bool         `json:"-"`
-	Testing        *testing.T   `json:"-"`
-	Client         *http.Client `json:"-"`
+	ValidateOnly   bool            `json:"-"`
+	PartialFailure bool            `json:"-"`
+	Testing        *testing.T      `json:"-"` // requests are logged in the test output if set
+	Client         *http.Client    `json:"-"`
+	Context        context.Context `json:"-"` // used for every request, context.Background() if nil
+	RetryPolicy    *RetryPolicy    `json:"-"` // failed requests are not retried if nil
+
+	// Endpoint is the root url the requests are sent to, DefaultEndpoint if
+	// empty. It can point to a proxy or a fake server.
+	Endpoint string `json:",omitempty"`
+
+	// APIVersion is the version of the api used for the urls and the xml
+	// namespaces, as "v201809", v201806 if empty.
+	APIVersion string `json:",omitempty"`
+
+	// Interceptors are called in order around every soap request, see
+	// Interceptor.
+	Interceptors []Interceptor `json:"-"`
+
+	// OnResponseHeader is called with the header of every soap response,
+	// faults included.
+	OnResponseHeader func(ResponseHeader) `json:"-"`
+
+	// Cassette records the http traffic of the Auth, or replays it instead
+	// of sending the requests, see Cassette.
+	Cassette *Cassette `json:"-"`
+}
+
+// ResponseHeader is the metadata sent back by the api with every soap
+// response.
+//
+// see https://developers.google.com/adwords/api/docs/reference/v201806/CampaignService.SoapResponseHeader
+type ResponseHeader struct {
+	RequestId    string `xml:"requestId"` // to give to the support when reporting an issue
+	ServiceName  string `xml:"serviceName"`
+	MethodName   string `xml:"methodName"`
+	Operations   int64  `xml:"operations"`   // number of operations charged on the quota
+	ResponseTime int64  `xml:"responseTime"` // in milliseconds
+}
+
+// WithContext returns a copy of the Auth whose requests are bound to ctx,
+// so they are aborted as soon as ctx is cancelled or its deadline expires.
+//
+//   cs := gads.NewCampaignService(auth.WithContext(ctx))
+//
+func (a Auth) WithContext(ctx context.Context) *Auth {
+	a.Context = ctx
+	return &a
+}
+
+// context returns the context the requests must be bound to
+func (a *Auth) context() context.Context {
+	if a.Context == nil {
+		return context.Background()
+	}
+	return a.Context
+}
+
+// version returns the api version the requests are made with
+func (a *Auth) version() string {
+	if a.APIVersion == "" {
+		return apiVersion
+	}
+	return a.APIVersion
+}
+
+// endpoint returns the root url of the api, without trailing slash
+func (a *Auth) endpoint() string {
+	if a.Endpoint == "" {
+		return DefaultEndpoint
+	}
+	return strings.TrimSuffix(a.Endpoint, "/")
+}
+
+// namespace returns the xml namespace of the service in the api version of
+// the Auth. Namespaces always refer to the production host, whatever the
+// endpoint.
+func (a *Auth) namespace(serviceUrl ServiceUrl) string {
+	return strings.TrimSuffix(serviceUrl.Url, apiVersion) + a.version()
+}
+
+// serviceURL returns the url the requests to the service are posted to
+func (a *Auth) serviceURL(serviceUrl ServiceUrl) string {
+	return a.endpoint() + strings.TrimPrefix(a.namespace(serviceUrl), DefaultEndpoint) + "/" + serviceUrl.Name
+}
+
+// cmOperator is the operator of an operation sent to a service outside of
+// the cm namespace, as the type of the operator is defined in cm.
+type cmOperator struct {
+	XMLName xml.Name
+	Action  string `xml:",chardata"`
+}
+
+// cmOperator returns the operator of an operation for the api version of
+// the Auth
+func (a *Auth) cmOperator(action string) cmOperator {
+	return cmOperator{
+		XMLName: xml.Name{Space: a.namespace(ServiceUrl{baseUrl, ""}), Local: "operator"},
+		Action:  action,
+	}
+}
+
+// reportURL returns the url of the report download api
+func (a *Auth) reportURL() string {
+	return a.endpoint() + "/reportdownload/" + a.version()
 }
 
 // Date is a google date, a simple type inference with methods
@@ -189,7 +300,7 @@ func (a *Auth) request(
 		soapReqEnvelope{
 			XMLName: xml.Name{"http://schemas.xmlsoap.org/soap/envelope/", "Envelope"},
 			Header: soapReqHeader{
-				XMLName:          xml.Name{serviceUrl.Url, "RequestHeader"},
+				XMLName:          xml.Name{a.namespace(serviceUrl), "RequestHeader"},
 				UserAgent:        a.UserAgent,
 				DeveloperToken:   a.DeveloperToken,
 				ClientCustomerId: a.CustomerId,
@@ -206,32 +317,56 @@ func (a *Auth) request(
 		return []byte{}, err
 	}
 
-	req, err := http.NewRequest("POST", serviceUrl.String(), bytes.NewReader(reqBody))
+	if a.RetryPolicy == nil {
+		return a.send(serviceUrl, action, reqBody)
+	}
+	return a.RetryPolicy.do(a.context(), func() ([]byte, error) {
+		return a.send(serviceUrl, action, reqBody)
+	})
+}
+
+// send posts the soap envelope reqBody to the service and returns the
+// content of the soap body of the response
+func (a *Auth) send(serviceUrl ServiceUrl, action string, reqBody []byte) (respBody []byte, err error) {
+	req, err := http.NewRequest("POST", a.serviceURL(serviceUrl), bytes.NewReader(reqBody))
+	if err != nil {
+		return []byte{}, err
+	}
+	req = req.WithContext(a.context())
 	req.Header.Add("Accept", "text/xml")
 	req.Header.Add("Accept", "multipart/*")
 	req.Header.Add("Content-Type", "text/xml;charset=UTF-8")
 	contentLength := fmt.Sprintf("%d", len(reqBody))
 	req.Header.Add("Content-length", contentLength)
 	req.Header.Add("SOAPAction", action)
-	if a.Testing != nil {
-		a.Testing.Logf("request ->\n%s\n%#v\n%s\n", req.URL.String(), req.Header, string(reqBody))
+
+	interceptors := a.interceptors()
+	defer func() {
+		if err != nil {
+			for _, i := range interceptors {
+				i.OnError(req, err)
+			}
+		}
+	}()
+	for _, i := range interceptors {
+		if err = i.BeforeSend(req, reqBody); err != nil {
+			return []byte{}, err
+		}
 	}
-	resp, err := a.Client.Do(req)
+	resp, err := a.client().Do(req)
 	if err != nil {
 		return []byte{}, err
 	}
+	defer resp.Body.Close()
 
 	respBody, err = ioutil.ReadAll(resp.Body)
-	if a.Testing != nil {
-		a.Testing.Logf("respBody ->\n%s\n%s\n", string(respBody), resp.Status)
+	if err != nil {
+		return respBody, err
 	}
-
-	type soapRespHeader struct {
-		RequestId    string `xml:"requestId"`
-		ServiceName  string `xml:"serviceName"`
-		MethodName   string `xml:"methodName"`
-		Operations   int64  `xml:"operations"`
-		ResponseTime int64  `xml:"responseTime"`
+	for _, i := range interceptors {
+		if respBody, err = i.AfterReceive(req, resp, respBody); err != nil {
+			return respBody, err
+		}
 	}
 
 	type soapRespBody struct {
@@ -240,7 +375,7 @@ func (a *Auth) request(
 
 	soapResp := struct {
 		XMLName xml.Name       `xml:"http://schemas.xmlsoap.org/soap/envelope/ Envelope"`
-		Header  soapRespHeader `xml:"Header>RequestHeader"`
+		Header  ResponseHeader `xml:"Header>ResponseHeader"`
 		Body    soapRespBody   `xml:"http://schemas.xmlsoap.org/soap/envelope/ Body"`
 	}{}
 
@@ -248,6 +383,9 @@ func (a *Auth) request(
 	if err != nil {
 		return respBody, err
 	}
+	if a.OnResponseHeader != nil {
+		a.OnResponseHeader(soapResp.Header)
+	}
 	if resp.StatusCode == 400 || resp.StatusCode == 401 || resp.StatusCode == 403 || resp.StatusCode == 405 || resp.StatusCode == 500 {
 		fault := Fault{}
 		// fmt.Printf("unknown error ->\n%s\n", string(soapResp.Body.Response))
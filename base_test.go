@@ -2,8 +2,20 @@ package gads
 
 import (
 	"crypto/rand"
-	"golang.org/x/net/context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"golang.org/x/net/context"
 )
 
 func rand_str(str_size int) string {
@@ -26,11 +38,220 @@ func rand_word(str_size int) string {
 	return string(bytes)
 }
 
+var record = flag.Bool("record", false, "record the api traffic of the tests to testdata/cassettes")
+
+var testCassettes = struct {
+	sync.Mutex
+	m map[string]*Cassette
+}{m: map[string]*Cassette{}}
+
+// testCassette returns the cassette of the test, shared by all the Auth set
+// up by the test, nil if the test runs against the api without recording.
+func testCassette(t *testing.T) *Cassette {
+	testCassettes.Lock()
+	defer testCassettes.Unlock()
+	if cassette, ok := testCassettes.m[t.Name()]; ok {
+		return cassette
+	}
+
+	path := filepath.Join("testdata", "cassettes", strings.Replace(t.Name(), "/", "_", -1)+".jsonl")
+	var cassette *Cassette
+	var err error
+	switch {
+	case *record:
+		cassette, err = RecordCassette(path)
+	case fileExists(path):
+		cassette, err = LoadCassette(path)
+	default:
+		return nil
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCassettes.m[t.Name()] = cassette
+	t.Cleanup(func() {
+		testCassettes.Lock()
+		delete(testCassettes.m, t.Name())
+		testCassettes.Unlock()
+		if err := cassette.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+	return cassette
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// testAuthSetup returns the Auth of the config.json credentials. The test
+// replays its cassette instead if one was recorded with the -record flag.
 func testAuthSetup(t *testing.T) Auth {
+	cassette := testCassette(t)
+	if cassette != nil && cassette.replaying {
+		return Auth{CustomerId: "123-456-7890", Testing: t, Cassette: cassette}
+	}
 	config, err := NewCredentials(context.TODO())
 	if err != nil {
 		t.Fatal(err)
 	}
 	config.Auth.Testing = t
+	config.Auth.Cassette = cassette
 	return config.Auth
 }
+
+// testServerAuth returns an Auth whose requests are all sent to a local
+// server running handler instead of the adwords api.
+func testServerAuth(t *testing.T, handler http.HandlerFunc) (Auth, func()) {
+	server := httptest.NewServer(handler)
+	auth := Auth{
+		CustomerId:     "123-456-7890",
+		DeveloperToken: "developer-token",
+		UserAgent:      "gads test",
+		Client:         server.Client(),
+		Endpoint:       server.URL,
+	}
+	return auth, server.Close
+}
+
+const testServiceResponse = `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Body>
+    <%[1]sResponse xmlns="%[2]s" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">
+      <rval>%[3]s</rval>
+    </%[1]sResponse>
+  </soap:Body>
+</soap:Envelope>`
+
+// testRequest is a request received by the server of testServiceAuth
+type testRequest struct {
+	Service string // name of the service, such as SharedSetService
+	Action  string // get, mutate...
+	Body    string
+}
+
+// testServiceAuth returns an Auth whose requests are appended to requests
+// and answered in the namespace of their service, the rval of the response
+// being returned by respond.
+func testServiceAuth(t *testing.T, requests *[]testRequest, respond func(request testRequest) string) (Auth, func()) {
+	return testServerAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		request := testRequest{Service: path.Base(r.URL.Path), Action: r.Header.Get("SOAPAction"), Body: string(b)}
+		*requests = append(*requests, request)
+		namespace := DefaultEndpoint + strings.TrimPrefix(path.Dir(r.URL.Path), "/api/adwords")
+		fmt.Fprintf(w, testServiceResponse, request.Action, namespace, respond(request))
+	})
+}
+
+func TestAuthEndpoint(t *testing.T) {
+	var path, body string
+	auth, cleanup := testServerAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		path, body = r.URL.Path, string(b)
+		fmt.Fprint(w, strings.Replace(testCampaignGetResponse, "v201806", "v201809", -1))
+	})
+	defer cleanup()
+	auth.Endpoint += "/api/adwords/"
+	auth.APIVersion = "v201809"
+
+	campaigns, _, err := NewCampaignService(&auth).Get(Selector{Fields: []string{"Id"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(campaigns) != 1 {
+		t.Errorf("unexpected campaigns %#v", campaigns)
+	}
+	if path != "/api/adwords/cm/v201809/CampaignService" {
+		t.Errorf("unexpected request path %s", path)
+	}
+	if strings.Contains(body, "v201806") || !strings.Contains(body, `xmlns="https://adwords.google.com/api/adwords/cm/v201809"`) {
+		t.Errorf("unexpected namespaces in request\n%s", body)
+	}
+
+	var mcmBody string
+	auth, cleanup = testServerAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		mcmBody = string(b)
+	})
+	defer cleanup()
+	auth.APIVersion = "v201809"
+	NewManagedCustomerService(&auth).MutateLink(ManagedCustomerLinkOperations{"SET": {{ManagerCustomerID: 1}}})
+	if !strings.Contains(mcmBody, `<operator xmlns="https://adwords.google.com/api/adwords/cm/v201809">SET</operator>`) {
+		t.Errorf("the operator must be in the cm namespace of the version\n%s", mcmBody)
+	}
+}
+
+func TestAuthWithContext(t *testing.T) {
+	auth, cleanup := testServerAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		// the connection is only watched once the body is consumed
+		ioutil.ReadAll(r.Body)
+		<-r.Context().Done()
+	})
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	cs := NewCampaignService(auth.WithContext(ctx))
+	_, _, err := cs.Get(Selector{Fields: []string{"Id"}})
+	if err == nil {
+		t.Fatal("expected the request to be aborted")
+	}
+	if ctx.Err() != context.DeadlineExceeded {
+		t.Fatalf("expected the deadline to be exceeded, got %v", ctx.Err())
+	}
+
+	if auth.Context != nil {
+		t.Errorf("WithContext must not modify the original Auth")
+	}
+}
+
+func TestAuthOnResponseHeader(t *testing.T) {
+	auth, cleanup := testServerAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		ioutil.ReadAll(r.Body)
+		fmt.Fprint(w, `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Header>
+    <ResponseHeader xmlns="https://adwords.google.com/api/adwords/cm/v201806">
+      <requestId>0005a1b2c3d4e5f6</requestId>
+      <serviceName>LabelService</serviceName>
+      <methodName>mutate</methodName>
+      <operations>2</operations>
+      <responseTime>187</responseTime>
+    </ResponseHeader>
+  </soap:Header>
+  <soap:Body>
+    <mutateResponse xmlns="https://adwords.google.com/api/adwords/cm/v201806">
+      <rval>
+        <value><id>1</id><name>label 1</name></value>
+        <value><id>2</id><name>label 2</name></value>
+      </rval>
+    </mutateResponse>
+  </soap:Body>
+</soap:Envelope>`)
+	})
+	defer cleanup()
+
+	headers := []ResponseHeader{}
+	auth.OnResponseHeader = func(h ResponseHeader) {
+		headers = append(headers, h)
+	}
+	labels, err := NewLabelService(&auth).Mutate(LabelOperations{
+		"ADD": {NewTextLabel("label 1"), NewTextLabel("label 2")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(labels) != 2 {
+		t.Errorf("expected 2 labels, got %#v", labels)
+	}
+	expected := ResponseHeader{
+		RequestId:    "0005a1b2c3d4e5f6",
+		ServiceName:  "LabelService",
+		MethodName:   "mutate",
+		Operations:   2,
+		ResponseTime: 187,
+	}
+	if len(headers) != 1 || headers[0] != expected {
+		t.Errorf("expected the header %#v, got %#v", expected, headers)
+	}
+}
@@ -50,6 +50,38 @@ func ExampleCampaignService_Get() {
 	fmt.Printf("\tTotal number of campaigns found: %d.", totalCount)
 }
 
+func ExampleCampaignService_All() {
+	// load credentials from
+	authConf, _ := NewCredentials(context.TODO())
+	cs := NewCampaignService(&authConf.Auth)
+
+	// This example illustrates how to retrieve all the campaigns for an account.
+	campaigns := cs.All(
+		Selector{
+			Fields: []string{
+				"Id",
+				"Name",
+				"Status",
+			},
+			Ordering: []OrderBy{
+				{"Name", "ASCENDING"},
+			},
+		},
+	)
+	// The next page is fetched while the current one is printed.
+	campaigns.PageSize = 500
+	campaigns.Prefetch = true
+	defer campaigns.Close()
+	for campaigns.Next() {
+		c := campaigns.Value()
+		fmt.Printf("Campaign ID %d, name '%s' and status '%s'", c.Id, c.Name, c.Status)
+	}
+	if err := campaigns.Err(); err != nil {
+		fmt.Printf("Error occured finding campaigns")
+	}
+	fmt.Printf("\tTotal number of campaigns found: %d.", campaigns.TotalCount())
+}
+
 func ExampleCampaignService_Mutate() {
 	// load credentials from
 	authConf, err := NewCredentials(context.TODO())
@@ -0,0 +1,811 @@
+package gads
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BatchJobService runs large sets of mutate operations asynchronously. A
+// job is created, its operations are uploaded, then the job is polled
+// until done and its results are downloaded.
+//
+// Example
+//
+//   bjs := gads.NewBatchJobService(&config.Auth)
+//   results, err := bjs.Run(
+//     []gads.BatchJobOperation{
+//       {Action: "ADD", Operand: gads.Budget{Id: -1, Name: "budget", Amount: 1000000, Delivery: "STANDARD"}},
+//       {Action: "ADD", Operand: gads.Campaign{Id: -2, Name: "campaign", BudgetId: -1, ...}},
+//     },
+//     gads.PollPolicy{Interval: time.Minute, Timeout: 6 * time.Hour},
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/guides/batch-jobs
+//     https://developers.google.com/adwords/api/docs/reference/v201806/BatchJobService
+//
+type BatchJobService struct {
+	Auth
+}
+
+func NewBatchJobService(auth *Auth) *BatchJobService {
+	return &BatchJobService{Auth: *auth}
+}
+
+// PollPolicy describes how a long running operation, such as a batch job,
+// is polled until it is over. The operation is polled every Interval
+// until Timeout, or until the context of the Auth is done without Timeout.
+//
+// Example
+//
+//   results, err := bjs.Run(operations, gads.PollPolicy{Interval: time.Minute, Timeout: 6 * time.Hour})
+//
+type PollPolicy struct {
+	Interval time.Duration // delay between two polls, one second at least
+	Timeout  time.Duration // polling is given up past this duration, no limit if 0
+}
+
+// DefaultPollPolicy polls every 30 seconds until the operation is over or
+// the context of the Auth is done.
+var DefaultPollPolicy = PollPolicy{Interval: 30 * time.Second}
+
+// minPollInterval is the shortest delay between two polls, so that a zero
+// PollPolicy does not flood the api
+var minPollInterval = time.Second
+
+// errStillRunning is returned by poll once the policy gives up waiting
+var errStillRunning = errors.New("still running")
+
+// poll calls check until it reports done or fails, waiting Interval between
+// two calls. errStillRunning is returned once the operation is still
+// running after Timeout, the error of ctx once it is done.
+func (p PollPolicy) poll(ctx context.Context, check func() (done bool, err error)) error {
+	interval := p.Interval
+	if interval < minPollInterval {
+		interval = minPollInterval
+	}
+	var timeout <-chan time.Time
+	if p.Timeout > 0 {
+		timer := time.NewTimer(p.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timeout:
+			timer.Stop()
+			return errStillRunning
+		case <-timer.C:
+		}
+	}
+}
+
+// BatchJob is the state of a batch job
+type BatchJob struct {
+	Id                    int64                     `xml:"id,omitempty"`
+	Status                string                    `xml:"status,omitempty"` // "AWAITING_FILE", "ACTIVE", "CANCELING", "CANCELED", "DONE"
+	ProgressStats         *BatchJobProgressStats    `xml:"progressStats,omitempty"`
+	UploadUrl             *TemporaryUrl             `xml:"uploadUrl,omitempty"`
+	DownloadUrl           *TemporaryUrl             `xml:"downloadUrl,omitempty"`
+	ProcessingErrors      []BatchJobProcessingError `xml:"processingErrors,omitempty"`
+	DiskUsageQuotaBalance int64                     `xml:"diskUsageQuotaBalance,omitempty"`
+}
+
+type BatchJobProgressStats struct {
+	NumOperationsExecuted    int64 `xml:"numOperationsExecuted"`
+	NumOperationsSucceeded   int64 `xml:"numOperationsSucceeded"`
+	EstimatedPercentExecuted int   `xml:"estimatedPercentExecuted"`
+	NumResultsWritten        int64 `xml:"numResultsWritten"`
+}
+
+// TemporaryUrl is an url valid until its expiration date
+type TemporaryUrl struct {
+	Url        string `xml:"url"`
+	Expiration string `xml:"expiration"` // as "20180806 142319 America/New_York"
+}
+
+// BatchJobProcessingError is an error preventing the job to process its
+// operations, as a malformed upload.
+type BatchJobProcessingError struct {
+	FieldPath   string `xml:"fieldPath"`
+	Trigger     string `xml:"trigger"`
+	ErrorString string `xml:"errorString"`
+	Reason      string `xml:"reason"`
+}
+
+func (e BatchJobProcessingError) Error() string {
+	return e.ErrorString + " @ " + e.FieldPath
+}
+
+type BatchJobOperations map[string][]BatchJob
+
+// BatchJobOperation is a mutate operation run by a batch job. Operand is
+// one of
+//
+//   Budget, Campaign, CampaignLabel, CampaignCriterion, NegativeCampaignCriterion,
+//   AdGroup, AdGroupLabel, AdGroupAd, BiddableAdGroupCriterion, NegativeAdGroupCriterion,
+//   AdGroupBidModifier, FeedItem
+//
+// The entities added by the job can be given negative temporary ids, to be
+// referenced by the other operations of the job.
+type BatchJobOperation struct {
+	Action  string // "ADD", "SET" or "REMOVE"
+	Operand interface{}
+}
+
+// batchJobOperationTypes gives the xsi type of the operations by operand type
+var batchJobOperationTypes = map[reflect.Type]string{
+	reflect.TypeOf(Budget{}):                    "BudgetOperation",
+	reflect.TypeOf(Campaign{}):                  "CampaignOperation",
+	reflect.TypeOf(CampaignLabel{}):             "CampaignLabelOperation",
+	reflect.TypeOf(CampaignCriterion{}):         "CampaignCriterionOperation",
+	reflect.TypeOf(NegativeCampaignCriterion{}): "CampaignCriterionOperation",
+	reflect.TypeOf(AdGroup{}):                   "AdGroupOperation",
+	reflect.TypeOf(AdGroupLabel{}):              "AdGroupLabelOperation",
+	reflect.TypeOf(AdGroupAd{}):                 "AdGroupAdOperation",
+	reflect.TypeOf(BiddableAdGroupCriterion{}):  "AdGroupCriterionOperation",
+	reflect.TypeOf(NegativeAdGroupCriterion{}):  "AdGroupCriterionOperation",
+	reflect.TypeOf(AdGroupBidModifier{}):        "AdGroupBidModifierOperation",
+	reflect.TypeOf(FeedItem{}):                  "FeedItemOperation",
+}
+
+// BatchJobResult is the outcome of an operation of a batch job, Result
+// holds the mutated entity, of the type of the operand, unless the
+// operation failed with Errors.
+type BatchJobResult struct {
+	Index  int64
+	Result interface{}
+	Errors PartialFailureErrors
+}
+
+// Get returns the batch jobs matching the selector, and their total count.
+//
+// Selectable fields are
+//   "Id", "Status", "ProgressStats", "DownloadUrl", "ProcessingErrors", "DiskUsageQuotaBalance"
+//
+// filterable fields are
+//   "Id", "Status"
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/BatchJobService#get
+//
+func (s *BatchJobService) Get(selector Selector) (batchJobs []BatchJob, totalCount int64, err error) {
+	selector.XMLName = xml.Name{"", "selector"}
+	respBody, err := s.Auth.request(
+		batchJobServiceUrl,
+		"get",
+		struct {
+			XMLName xml.Name
+			Sel     Selector
+		}{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(batchJobServiceUrl),
+				Local: "get",
+			},
+			Sel: selector,
+		},
+	)
+	if err != nil {
+		return batchJobs, totalCount, err
+	}
+	getResp := struct {
+		Size      int64      `xml:"rval>totalNumEntries"`
+		BatchJobs []BatchJob `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return batchJobs, totalCount, err
+	}
+	return getResp.BatchJobs, getResp.Size, err
+}
+
+// All returns a Pager over all the batch jobs matching selector.
+func (s *BatchJobService) All(selector Selector) *Pager[BatchJob] {
+	return NewPager(s.Get, selector)
+}
+
+// Mutate adds batch jobs, or cancels them with a SET of their status to
+// CANCELING.
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/BatchJobService#mutate
+//
+func (s *BatchJobService) Mutate(batchJobOperations BatchJobOperations) (batchJobs []BatchJob, err error) {
+	type batchJobOperation struct {
+		Action   string   `xml:"operator"`
+		BatchJob BatchJob `xml:"operand"`
+	}
+	operations := []batchJobOperation{}
+	for action, batchJobs := range batchJobOperations {
+		for _, batchJob := range batchJobs {
+			operations = append(operations,
+				batchJobOperation{
+					Action:   action,
+					BatchJob: batchJob,
+				},
+			)
+		}
+	}
+	mutation := struct {
+		XMLName xml.Name
+		Ops     []batchJobOperation `xml:"operations"`
+	}{
+		XMLName: xml.Name{
+			Space: s.Auth.namespace(batchJobServiceUrl),
+			Local: "mutate",
+		},
+		Ops: operations,
+	}
+	respBody, err := s.Auth.request(batchJobServiceUrl, "mutate", mutation)
+	if err != nil {
+		return batchJobs, err
+	}
+	mutateResp := struct {
+		BaseResponse
+		BatchJobs []BatchJob `xml:"rval>value"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &mutateResp)
+	if err != nil {
+		return batchJobs, err
+	}
+
+	if len(mutateResp.PartialFailureErrors) > 0 {
+		err = mutateResp.PartialFailureErrors
+	}
+
+	return mutateResp.BatchJobs, err
+}
+
+// Create adds a new batch job, awaiting its operations to be uploaded to
+// its UploadUrl.
+func (s *BatchJobService) Create() (batchJob BatchJob, err error) {
+	batchJobs, err := s.Mutate(BatchJobOperations{"ADD": {{}}})
+	if err != nil {
+		return batchJob, err
+	}
+	if len(batchJobs) != 1 || batchJobs[0].UploadUrl == nil {
+		return batchJob, fmt.Errorf("no batch job returned")
+	}
+	return batchJobs[0], nil
+}
+
+// Cancel asks for the job to stop, the operations already run are not
+// rolled back.
+func (s *BatchJobService) Cancel(batchJobId int64) (batchJob BatchJob, err error) {
+	batchJobs, err := s.Mutate(BatchJobOperations{"SET": {{Id: batchJobId, Status: "CANCELING"}}})
+	if err != nil || len(batchJobs) == 0 {
+		return batchJob, err
+	}
+	return batchJobs[0], nil
+}
+
+// Wait polls the job until it is done or canceled, as set by policy. An
+// error is returned once the job is still running after policy.Timeout or
+// when the context of the Auth is done.
+func (s *BatchJobService) Wait(batchJobId int64, policy PollPolicy) (batchJob BatchJob, err error) {
+	selector := Selector{
+		Fields: []string{"Id", "Status", "ProgressStats", "DownloadUrl", "ProcessingErrors"},
+		Predicates: []Predicate{
+			{"Id", "EQUALS", []string{strconv.FormatInt(batchJobId, 10)}},
+		},
+	}
+	err = policy.poll(s.Auth.context(), func() (bool, error) {
+		batchJobs, _, err := s.Get(selector)
+		if err != nil {
+			return false, err
+		}
+		if len(batchJobs) == 0 {
+			return false, fmt.Errorf("batch job %d not found", batchJobId)
+		}
+		batchJob = batchJobs[0]
+		return batchJob.Status == "DONE" || batchJob.Status == "CANCELED", nil
+	})
+	if err == errStillRunning {
+		return batchJob, fmt.Errorf("batch job %d is still %s", batchJobId, batchJob.Status)
+	}
+	return batchJob, err
+}
+
+// Results downloads the results of a done job, results[i] being the
+// result of the operation i of the upload.
+func (s *BatchJobService) Results(batchJob BatchJob) (results []BatchJobResult, err error) {
+	if batchJob.DownloadUrl == nil || batchJob.DownloadUrl.Url == "" {
+		return results, fmt.Errorf("batch job %d has no results to download", batchJob.Id)
+	}
+	req, err := http.NewRequest("GET", batchJob.DownloadUrl.Url, nil)
+	if err != nil {
+		return results, err
+	}
+	resp, err := s.Auth.client().Do(req.WithContext(s.Auth.context()))
+	if err != nil {
+		return results, err
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return results, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return results, fmt.Errorf("batch job %d results: %s", batchJob.Id, resp.Status)
+	}
+
+	downloadResp := struct {
+		Results []BatchJobResult `xml:"rval>MutateResult"`
+	}{}
+	if err = xml.Unmarshal(respBody, &downloadResp); err != nil {
+		return results, err
+	}
+	for _, result := range downloadResp.Results {
+		for int64(len(results)) <= result.Index {
+			results = append(results, BatchJobResult{Index: int64(len(results))})
+		}
+		results[result.Index] = result
+	}
+	return results, nil
+}
+
+// Run runs the operations in a new batch job, waiting for the job as set by
+// policy, and returns their results in the order of operations.
+func (s *BatchJobService) Run(operations []BatchJobOperation, policy PollPolicy) (results []BatchJobResult, err error) {
+	batchJob, err := s.Create()
+	if err != nil {
+		return results, err
+	}
+	upload := s.NewUpload(batchJob)
+	if err = upload.Append(operations...); err != nil {
+		return results, err
+	}
+	if err = upload.Close(); err != nil {
+		return results, err
+	}
+	if batchJob, err = s.Wait(batchJob.Id, policy); err != nil {
+		return results, err
+	}
+	if len(batchJob.ProcessingErrors) > 0 {
+		return results, batchJob.ProcessingErrors[0]
+	}
+	return s.Results(batchJob)
+}
+
+func (r *BatchJobResult) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	for token, err := dec.Token(); err == nil; token, err = dec.Token() {
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch start.Name.Local {
+		case "index":
+			if err := dec.DecodeElement(&r.Index, &start); err != nil {
+				return err
+			}
+		case "errorList":
+			errorList := struct {
+				Errors PartialFailureErrors `xml:"errors"`
+			}{}
+			if err := dec.DecodeElement(&errorList, &start); err != nil {
+				return err
+			}
+			r.Errors = errorList.Errors
+		case "result":
+			if err := r.decodeResult(dec); err != nil {
+				return err
+			}
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// decodeResult decodes the entity held by the result element
+func (r *BatchJobResult) decodeResult(dec *xml.Decoder) error {
+	for token, err := dec.Token(); err == nil; token, err = dec.Token() {
+		switch start := token.(type) {
+		case xml.EndElement:
+			return nil
+		case xml.StartElement:
+			var err error
+			switch start.Name.Local {
+			case "Budget":
+				r.Result, err = decodeBatchJobEntity(dec, start, &Budget{})
+			case "Campaign":
+				r.Result, err = decodeBatchJobEntity(dec, start, &Campaign{})
+			case "CampaignLabel":
+				r.Result, err = decodeBatchJobEntity(dec, start, &CampaignLabel{})
+			case "CampaignCriterion":
+				r.Result, err = decodeBatchJobEntity(dec, start, &CampaignCriterions{})
+			case "AdGroup":
+				r.Result, err = decodeBatchJobEntity(dec, start, &AdGroup{})
+			case "AdGroupLabel":
+				r.Result, err = decodeBatchJobEntity(dec, start, &AdGroupLabel{})
+			case "AdGroupAd":
+				r.Result, err = decodeBatchJobEntity(dec, start, &AdGroupAds{})
+			case "AdGroupCriterion":
+				r.Result, err = decodeBatchJobEntity(dec, start, &AdGroupCriterions{})
+			case "AdGroupBidModifier":
+				r.Result, err = decodeBatchJobEntity(dec, start, &AdGroupBidModifier{})
+			case "FeedItem":
+				r.Result, err = decodeBatchJobEntity(dec, start, &FeedItem{})
+			default:
+				if StrictMode {
+					return fmt.Errorf("unknown batch job result -> %#v", start.Name.Local)
+				}
+				err = dec.Skip()
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// decodeBatchJobEntity decodes the element in entity, the slices decoding
+// polymorphic entities give their only element.
+func decodeBatchJobEntity(dec *xml.Decoder, start xml.StartElement, entity interface{}) (interface{}, error) {
+	if err := dec.DecodeElement(entity, &start); err != nil {
+		return nil, err
+	}
+	v := reflect.ValueOf(entity).Elem()
+	if v.Kind() == reflect.Slice {
+		if v.Len() == 0 {
+			return nil, nil
+		}
+		return v.Index(0).Interface(), nil
+	}
+	return v.Interface(), nil
+}
+
+// uploadAlignment is the size the incremental uploads must be a multiple
+// of, but the last one.
+const uploadAlignment = 256 * 1024
+
+// DefaultUploadChunkSize is the maximum size of the upload requests of a
+// BatchJobUpload whose ChunkSize is not set.
+const DefaultUploadChunkSize = 32 * uploadAlignment
+
+// BatchJobUpload uploads the operations of a batch job incrementally, in
+// chunks, with the resumable upload protocol. The requests failing with a
+// network error or a 5xx status are resumed as set by the RetryPolicy of
+// the Auth.
+//
+// Example
+//
+//   upload := batchJobService.NewUpload(batchJob)
+//   for _, operations := range nightlyChanges {
+//     if err := upload.Append(operations...); err != nil {
+//       return err
+//     }
+//   }
+//   err = upload.Close()
+//
+type BatchJobUpload struct {
+	// SessionURL and Offset are the state of the upload, saved to resume
+	// it later on with ResumeUpload.
+	SessionURL string
+	Offset     int64
+
+	// ChunkSize is the maximum size of a request, rounded to a multiple of
+	// 256KiB, DefaultUploadChunkSize if zero.
+	ChunkSize int
+
+	auth      *Auth
+	uploadURL string
+	closed    bool
+}
+
+// NewUpload returns a BatchJobUpload to the upload url of batchJob
+func (s *BatchJobService) NewUpload(batchJob BatchJob) *BatchJobUpload {
+	upload := &BatchJobUpload{auth: &s.Auth}
+	if batchJob.UploadUrl != nil {
+		upload.uploadURL = batchJob.UploadUrl.Url
+	}
+	return upload
+}
+
+// ResumeUpload returns a BatchJobUpload going on with the upload started
+// at sessionURL, offset bytes being uploaded yet.
+func (s *BatchJobService) ResumeUpload(sessionURL string, offset int64) *BatchJobUpload {
+	return &BatchJobUpload{auth: &s.Auth, SessionURL: sessionURL, Offset: offset}
+}
+
+// Append serializes the operations and uploads them.
+func (u *BatchJobUpload) Append(operations ...BatchJobOperation) error {
+	if u.closed {
+		return fmt.Errorf("batch job upload is closed")
+	}
+	data, err := u.encode(operations)
+	if err != nil {
+		return err
+	}
+	if u.Offset == 0 {
+		data = append(u.header(), data...)
+	}
+	if padding := len(data) % uploadAlignment; padding > 0 {
+		data = append(data, bytes.Repeat([]byte(" "), uploadAlignment-padding)...)
+	}
+	for len(data) > 0 {
+		chunk := data
+		if len(chunk) > u.chunkSize() {
+			chunk = chunk[:u.chunkSize()]
+		}
+		if err := u.put(chunk, false); err != nil {
+			return err
+		}
+		data = data[len(chunk):]
+	}
+	return nil
+}
+
+// Close ends the upload, the job starts running its operations.
+func (u *BatchJobUpload) Close() error {
+	if u.closed {
+		return nil
+	}
+	data := []byte("</mutate>")
+	if u.Offset == 0 {
+		data = append(u.header(), data...)
+	}
+	if err := u.put(data, true); err != nil {
+		return err
+	}
+	u.closed = true
+	return nil
+}
+
+// header returns the beginning of the upload
+func (u *BatchJobUpload) header() []byte {
+	return []byte(fmt.Sprintf(
+		`%s<mutate xmlns="%s" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">`,
+		xml.Header, u.auth.namespace(batchJobServiceUrl),
+	))
+}
+
+// encode serializes the operations as operations elements
+func (u *BatchJobUpload) encode(operations []BatchJobOperation) ([]byte, error) {
+	type batchJobOperation struct {
+		XMLName xml.Name    `xml:"operations"`
+		Type    string      `xml:"xsi:type,attr"`
+		Action  string      `xml:"operator"`
+		Operand interface{} `xml:"operand"`
+	}
+	data := []byte{}
+	for _, operation := range operations {
+		operand := operation.Operand
+		operationType, ok := batchJobOperationTypes[reflect.TypeOf(operand)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported batch job operand %T", operand)
+		}
+		if campaign, ok := operand.(Campaign); ok {
+			// you can't mutate those fields
+			campaign.CampaignTrialType = nil
+			campaign.AdServingOptimizationStatus = ""
+			operand = campaign
+		}
+		b, err := xml.Marshal(addXSIType(batchJobOperation{
+			Type:    operationType,
+			Action:  operation.Action,
+			Operand: operand,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, b...)
+	}
+	return data, nil
+}
+
+func (u *BatchJobUpload) chunkSize() int {
+	if u.ChunkSize <= 0 {
+		return DefaultUploadChunkSize
+	}
+	return (u.ChunkSize + uploadAlignment - 1) / uploadAlignment * uploadAlignment
+}
+
+// start opens the upload session
+func (u *BatchJobUpload) start() error {
+	if u.uploadURL == "" {
+		return fmt.Errorf("batch job has no upload url")
+	}
+	req, err := http.NewRequest("POST", u.uploadURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("x-goog-resumable", "start")
+	resp, err := u.do(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("batch job upload start: %s", resp.Status)
+	}
+	if u.SessionURL = resp.Header.Get("Location"); u.SessionURL == "" {
+		return fmt.Errorf("batch job upload start: no session url")
+	}
+	return nil
+}
+
+// put uploads chunk at Offset, resuming the upload on failure
+func (u *BatchJobUpload) put(chunk []byte, last bool) error {
+	if u.SessionURL == "" {
+		if err := u.start(); err != nil {
+			return err
+		}
+	}
+	total := "*"
+	if last {
+		total = strconv.FormatInt(u.Offset+int64(len(chunk)), 10)
+	}
+	start := time.Now()
+	for retry := 0; ; {
+		sent, err := u.putOnce(chunk, total)
+		if err == nil {
+			// the server may acknowledge a part of the chunk only, the rest
+			// is sent again
+			u.Offset += sent
+			chunk = chunk[sent:]
+			if len(chunk) == 0 {
+				return nil
+			}
+			if sent > 0 {
+				continue
+			}
+			err = uploadError{errors.New("batch job upload: no byte of the chunk acknowledged")}
+		}
+		policy := u.auth.RetryPolicy
+		if _, retryable := err.(uploadError); !retryable || policy == nil || retry >= policy.MaxRetries {
+			return err
+		}
+		delay := policy.backoff(retry)
+		if policy.MaxElapsed > 0 && time.Since(start)+delay > policy.MaxElapsed {
+			return err
+		}
+		retry++
+		timer := time.NewTimer(delay)
+		select {
+		case <-u.auth.context().Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+
+		// the server may have received a part of the chunk
+		committed, complete, err := u.committed(total)
+		if err != nil {
+			return err
+		}
+		if complete {
+			u.Offset += int64(len(chunk))
+			return nil
+		}
+		if committed > u.Offset {
+			skip := committed - u.Offset
+			if skip > int64(len(chunk)) {
+				skip = int64(len(chunk))
+			}
+			chunk = chunk[skip:]
+			u.Offset = committed
+		}
+		if len(chunk) == 0 {
+			return nil
+		}
+	}
+}
+
+// uploadError is an upload failure worth resuming
+type uploadError struct {
+	err error
+}
+
+func (e uploadError) Error() string {
+	return e.err.Error()
+}
+
+// putOnce sends chunk at Offset and returns the number of its bytes the
+// server committed
+func (u *BatchJobUpload) putOnce(chunk []byte, total string) (sent int64, err error) {
+	req, err := http.NewRequest("PUT", u.SessionURL, bytes.NewReader(chunk))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%s", u.Offset, u.Offset+int64(len(chunk))-1, total))
+	resp, err := u.do(req)
+	if err != nil {
+		return 0, uploadError{err}
+	}
+	switch {
+	case resp.StatusCode == http.StatusPermanentRedirect:
+		// "308 Resume Incomplete", the upload goes on from the end of the
+		// range the server acknowledged
+		committed, err := acknowledged(resp)
+		if err != nil {
+			return 0, err
+		}
+		sent = committed - u.Offset
+		if sent < 0 {
+			sent = 0
+		}
+		if sent > int64(len(chunk)) {
+			sent = int64(len(chunk))
+		}
+		return sent, nil
+	case resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated:
+		return int64(len(chunk)), nil
+	case resp.StatusCode >= 500:
+		return 0, uploadError{fmt.Errorf("batch job upload: %s", resp.Status)}
+	}
+	return 0, fmt.Errorf("batch job upload: %s", resp.Status)
+}
+
+// committed asks the server the number of bytes it received, complete
+// being true if the upload is over
+func (u *BatchJobUpload) committed(total string) (committed int64, complete bool, err error) {
+	req, err := http.NewRequest("PUT", u.SessionURL, nil)
+	if err != nil {
+		return 0, false, err
+	}
+	req.Header.Set("Content-Range", "bytes */"+total)
+	resp, err := u.do(req)
+	if err != nil {
+		return 0, false, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		return 0, true, nil
+	case http.StatusPermanentRedirect:
+	default:
+		return 0, false, fmt.Errorf("batch job upload status: %s", resp.Status)
+	}
+	committed, err = acknowledged(resp)
+	return committed, false, err
+}
+
+// acknowledged returns the number of bytes received by the server, as
+// given by the Range header of a 308 response
+func acknowledged(resp *http.Response) (int64, error) {
+	// as "bytes=0-524287", missing if nothing was received
+	r := resp.Header.Get("Range")
+	if r == "" {
+		return 0, nil
+	}
+	last, err := strconv.ParseInt(r[strings.LastIndex(r, "-")+1:], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("batch job upload status: invalid range %q", r)
+	}
+	return last + 1, nil
+}
+
+// do sends the request, the response body is discarded
+func (u *BatchJobUpload) do(req *http.Request) (*http.Response, error) {
+	resp, err := u.auth.client().Do(req.WithContext(u.auth.context()))
+	if err != nil {
+		return nil, err
+	}
+	ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	return resp, nil
+}
@@ -0,0 +1,347 @@
+package gads
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testBatchJobResponse = `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Body>
+    <%[1]sResponse xmlns="https://adwords.google.com/api/adwords/cm/v201806">
+      <rval>
+        <totalNumEntries>1</totalNumEntries>
+        <%[2]s>
+          <id>42</id>
+          <status>%[3]s</status>
+          <uploadUrl><url>%[4]s/upload</url></uploadUrl>
+          <downloadUrl><url>%[4]s/download</url></downloadUrl>
+        </%[2]s>
+      </rval>
+    </%[1]sResponse>
+  </soap:Body>
+</soap:Envelope>`
+
+const testBatchJobResults = `<?xml version="1.0" encoding="UTF-8"?>
+<ns2:mutateResponse xmlns="https://adwords.google.com/api/adwords/cm/v201806" xmlns:ns2="https://adwords.google.com/api/adwords/cm/v201806">
+  <rval>
+    <MutateResult>
+      <errorList>
+        <errors xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CampaignError">
+          <fieldPath>operations[2].operand.name</fieldPath>
+          <trigger>campaign</trigger>
+          <errorString>CampaignError.DUPLICATE_CAMPAIGN_NAME</errorString>
+          <reason>DUPLICATE_CAMPAIGN_NAME</reason>
+        </errors>
+      </errorList>
+      <index>2</index>
+    </MutateResult>
+    <MutateResult>
+      <result><Budget><budgetId>7</budgetId><name>budget</name></Budget></result>
+      <index>0</index>
+    </MutateResult>
+    <MutateResult>
+      <result>
+        <AdGroupCriterion xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="BiddableAdGroupCriterion">
+          <adGroupId>8</adGroupId>
+          <criterion xsi:type="Keyword"><id>9</id><type>KEYWORD</type><text>keyword</text><matchType>EXACT</matchType></criterion>
+        </AdGroupCriterion>
+      </result>
+      <index>1</index>
+    </MutateResult>
+  </rval>
+</ns2:mutateResponse>`
+
+// testBatchJobServer fakes the batch job service, the upload and the
+// download of the results. The first chunk uploaded is only half received.
+type testBatchJobServer struct {
+	mu       sync.Mutex
+	uploaded []byte
+	ranges   []string
+	polls    int
+	failed   bool
+}
+
+func (s *testBatchJobServer) handler(url func() string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		body, _ := ioutil.ReadAll(r.Body)
+		switch {
+		case r.URL.Path == "/upload":
+			if r.Header.Get("x-goog-resumable") != "start" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Location", url()+"/session")
+			w.WriteHeader(http.StatusCreated)
+		case r.URL.Path == "/session":
+			contentRange := r.Header.Get("Content-Range")
+			s.ranges = append(s.ranges, contentRange)
+			if strings.HasPrefix(contentRange, "bytes */") {
+				w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", len(s.uploaded)-1))
+				w.WriteHeader(http.StatusPermanentRedirect)
+				return
+			}
+			if !s.failed {
+				s.failed = true
+				s.uploaded = append(s.uploaded, body[:len(body)/2]...)
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			s.uploaded = append(s.uploaded, body...)
+			if strings.HasSuffix(contentRange, "/*") {
+				w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", len(s.uploaded)-1))
+				w.WriteHeader(http.StatusPermanentRedirect)
+			}
+		case r.URL.Path == "/download":
+			fmt.Fprint(w, testBatchJobResults)
+		case r.Header.Get("SOAPAction") == "mutate":
+			fmt.Fprintf(w, testBatchJobResponse, "mutate", "value", "AWAITING_FILE", url())
+		case r.Header.Get("SOAPAction") == "get":
+			s.polls++
+			status := "ACTIVE"
+			if s.polls > 2 {
+				status = "DONE"
+			}
+			fmt.Fprintf(w, testBatchJobResponse, "get", "entries", status, url())
+		}
+	}
+}
+
+func TestBatchJob(t *testing.T) {
+	server := &testBatchJobServer{}
+	var serverURL string
+	auth, cleanup := testServerAuth(t, server.handler(func() string { return serverURL }))
+	defer cleanup()
+	serverURL = auth.Endpoint
+	auth.RetryPolicy = &RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond}
+
+	operations := []BatchJobOperation{}
+	for i := 0; i < 2000; i++ {
+		operations = append(operations, BatchJobOperation{
+			Action:  "ADD",
+			Operand: Budget{Id: int64(-i - 1), Name: "budget " + strconv.Itoa(i), Amount: 1000000, Delivery: "STANDARD"},
+		})
+	}
+	operations = append(operations,
+		BatchJobOperation{
+			Action: "ADD",
+			Operand: BiddableAdGroupCriterion{
+				AdGroupId: 8,
+				Criterion: KeywordCriterion{Text: "keyword", MatchType: "EXACT"},
+			},
+		},
+		BatchJobOperation{Action: "ADD", Operand: Campaign{Name: "campaign", BudgetId: -1}},
+	)
+
+	defer func(interval time.Duration) { minPollInterval = interval }(minPollInterval)
+	minPollInterval = time.Millisecond
+
+	bjs := NewBatchJobService(&auth)
+	results, err := bjs.Run(operations, PollPolicy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the upload is a valid document, resumed after the failed chunk
+	uploaded := struct {
+		XMLName    xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201806 mutate"`
+		Operations []struct {
+			Type    string `xml:"http://www.w3.org/2001/XMLSchema-instance type,attr"`
+			Action  string `xml:"operator"`
+			Operand struct {
+				Name      string `xml:"name"`
+				Criterion struct {
+					Type string `xml:"http://www.w3.org/2001/XMLSchema-instance type,attr"`
+				} `xml:"criterion"`
+			} `xml:"operand"`
+		} `xml:"operations"`
+	}{}
+	if err := xml.Unmarshal(server.uploaded, &uploaded); err != nil {
+		t.Fatalf("%s\n%s", err, server.uploaded[len(server.uploaded)-500:])
+	}
+	if len(uploaded.Operations) != len(operations) {
+		t.Fatalf("expected %d operations uploaded, got %d", len(operations), len(uploaded.Operations))
+	}
+	first, last := uploaded.Operations[0], uploaded.Operations[len(operations)-2:]
+	if first.Type != "BudgetOperation" || first.Action != "ADD" || first.Operand.Name != "budget 0" ||
+		last[0].Type != "AdGroupCriterionOperation" || last[0].Operand.Criterion.Type != "Keyword" ||
+		last[1].Type != "CampaignOperation" || last[1].Operand.Name != "campaign" {
+		t.Errorf("unexpected operations %+v %+v", first, last)
+	}
+	if !bytes.HasPrefix(server.uploaded, []byte(xml.Header)) {
+		t.Error("the upload must start with the xml header")
+	}
+	expectedRanges := []string{
+		fmt.Sprintf("bytes 0-%d/*", 2*uploadAlignment-1),
+		"bytes */*",
+		fmt.Sprintf("bytes %d-%d/*", uploadAlignment, 2*uploadAlignment-1),
+		fmt.Sprintf("bytes %d-%d/%d", 2*uploadAlignment, 2*uploadAlignment+8, 2*uploadAlignment+9),
+	}
+	if strings.Join(server.ranges, ", ") != strings.Join(expectedRanges, ", ") {
+		t.Errorf("expected the ranges %q, got %q", expectedRanges, server.ranges)
+	}
+
+	// the results are in the order of the operations
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %#v", results)
+	}
+	if budget, ok := results[0].Result.(Budget); !ok || budget.Id != 7 {
+		t.Errorf("unexpected result %#v", results[0])
+	}
+	criterion, ok := results[1].Result.(BiddableAdGroupCriterion)
+	if !ok || criterion.Criterion.(KeywordCriterion).Id != 9 {
+		t.Errorf("unexpected result %#v", results[1])
+	}
+	if results[2].Result != nil || len(results[2].Errors) != 1 || results[2].Errors[0].Code != "CampaignError.DUPLICATE_CAMPAIGN_NAME" {
+		t.Errorf("unexpected result %#v", results[2])
+	}
+	if server.polls != 3 {
+		t.Errorf("expected the job to be polled until done, got %d polls", server.polls)
+	}
+}
+
+func TestBatchJobUploadChunks(t *testing.T) {
+	server := &testBatchJobServer{failed: true}
+	var serverURL string
+	auth, cleanup := testServerAuth(t, server.handler(func() string { return serverURL }))
+	defer cleanup()
+	serverURL = auth.Endpoint
+
+	upload := NewBatchJobService(&auth).NewUpload(BatchJob{UploadUrl: &TemporaryUrl{Url: serverURL + "/upload"}})
+	upload.ChunkSize = 1000 // rounded to 256KiB
+	for i := 0; i < 3; i++ {
+		budgets := []BatchJobOperation{}
+		for j := 0; j < 1000; j++ {
+			budgets = append(budgets, BatchJobOperation{Action: "ADD", Operand: Budget{Name: strconv.Itoa(j)}})
+		}
+		if err := upload.Append(budgets...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := upload.Close(); err != nil {
+		t.Fatal(err)
+	}
+	for i, contentRange := range server.ranges[:len(server.ranges)-1] {
+		expected := fmt.Sprintf("bytes %d-%d/*", i*uploadAlignment, (i+1)*uploadAlignment-1)
+		if contentRange != expected {
+			t.Errorf("expected the range %s, got %s", expected, contentRange)
+		}
+	}
+	if int64(len(server.uploaded)) != upload.Offset {
+		t.Errorf("expected %d bytes uploaded, got %d", upload.Offset, len(server.uploaded))
+	}
+	if err := xml.Unmarshal(server.uploaded, new(struct{})); err != nil {
+		t.Errorf("the upload must be a valid xml document, %s", err)
+	}
+}
+
+func TestBatchJobUploadCanceled(t *testing.T) {
+	auth, cleanup := testServerAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer cleanup()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	auth.RetryPolicy = &RetryPolicy{MaxRetries: 3, BaseDelay: time.Hour}
+
+	upload := NewBatchJobService(auth.WithContext(ctx)).ResumeUpload(auth.Endpoint+"/session", 0)
+	start := time.Now()
+	if err := upload.Close(); err == nil {
+		t.Error("expected the upload to fail")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected the retry delay to end with the context, waited %s", elapsed)
+	}
+}
+
+func TestBatchJobUploadPartialAck(t *testing.T) {
+	// the server commits the first half of the chunks only
+	uploaded, ranges := []byte{}, []string{}
+	auth, cleanup := testServerAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		contentRange := r.Header.Get("Content-Range")
+		ranges = append(ranges, contentRange)
+		if !strings.HasPrefix(contentRange, fmt.Sprintf("bytes %d-", len(uploaded))) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if len(body) > 1 {
+			body = body[:len(body)/2]
+		}
+		uploaded = append(uploaded, body...)
+		if contentRange[strings.LastIndex(contentRange, "/")+1:] == strconv.Itoa(len(uploaded)) {
+			return
+		}
+		w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", len(uploaded)-1))
+		w.WriteHeader(http.StatusPermanentRedirect)
+	})
+	defer cleanup()
+
+	upload := NewBatchJobService(&auth).ResumeUpload(auth.Endpoint+"/session", 0)
+	if err := upload.Append(BatchJobOperation{Action: "ADD", Operand: Budget{Name: "budget"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := upload.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if int64(len(uploaded)) != upload.Offset {
+		t.Errorf("expected %d bytes uploaded, got %d", upload.Offset, len(uploaded))
+	}
+	if len(ranges) < 3 {
+		t.Errorf("expected the unacknowledged bytes to be sent again, got the ranges %q", ranges)
+	}
+	if err := xml.Unmarshal(uploaded, new(struct{})); err != nil {
+		t.Errorf("the upload must be a valid xml document, %s", err)
+	}
+}
+
+func TestBatchJobUnsupportedOperand(t *testing.T) {
+	upload := NewBatchJobService(&Auth{}).NewUpload(BatchJob{})
+	if err := upload.Append(BatchJobOperation{Action: "ADD", Operand: &Campaign{}}); err == nil {
+		t.Error("expected an unsupported operand to be rejected")
+	}
+}
+
+func TestPollPolicy(t *testing.T) {
+	defer func(interval time.Duration) { minPollInterval = interval }(minPollInterval)
+	minPollInterval = 10 * time.Millisecond
+
+	polls := 0
+	start := time.Now()
+	err := PollPolicy{}.poll(context.Background(), func() (bool, error) {
+		polls++
+		return polls == 3, nil
+	})
+	if err != nil || polls != 3 {
+		t.Fatalf("expected 3 polls, got %d, %v", polls, err)
+	}
+	if elapsed := time.Since(start); elapsed < 2*minPollInterval {
+		t.Errorf("expected a zero policy to wait the minimum interval between polls, polled 3 times in %s", elapsed)
+	}
+
+	polls = 0
+	err = PollPolicy{Interval: 10 * time.Millisecond, Timeout: 35 * time.Millisecond}.poll(context.Background(), func() (bool, error) {
+		polls++
+		return false, nil
+	})
+	if err != errStillRunning || polls < 3 || polls > 5 {
+		t.Errorf("expected the polling to be given up after the timeout, got %v after %d polls", err, polls)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 25*time.Millisecond)
+	defer cancel()
+	err = PollPolicy{Interval: 10 * time.Millisecond}.poll(ctx, func() (bool, error) { return false, nil })
+	if err != context.DeadlineExceeded {
+		t.Errorf("expected the polling to stop with the context, got %v", err)
+	}
+}
@@ -64,8 +64,28 @@ func (b *SharedBiddingStrategy) UnmarshalXML(dec *xml.Decoder, start xml.StartEl
 // on.  BiddingStrategy operations can be 'ADD', 'REMOVE' or 'SET'
 type BiddingStrategyOperations map[string][]SharedBiddingStrategy
 
+// BiddingStrategyFields lists the fields of the shared bidding strategies
+// the BiddingStrategyService selects and filters on, see FieldCatalog.
+var BiddingStrategyFields = FieldCatalog{
+	Service: "BiddingStrategyService",
+	Selectable: []string{
+		"Id", "Name", "Status", "Type", "BiddingScheme", "TargetCpa", "TargetCpaMaxCpcBidCeiling",
+		"TargetCpaMaxCpcBidFloor", "TargetRoas", "TargetRoasBidCeiling", "TargetRoasBidFloor",
+		"TargetSpendBidCeiling", "TargetSpendSpendTarget", "TargetOutrankShare", "CompetitorDomain",
+		"TargetOutrankShareMaxCpcBidCeiling", "TargetOutrankShareBidChangesForRaisesOnly",
+		"TargetOutrankShareRaiseBidWhenLowQualityScore", "PageOnePromotedStrategyGoal",
+		"PageOnePromotedBidCeiling", "PageOnePromotedBidModifier",
+		"PageOnePromotedBidChangesForRaisesOnly", "PageOnePromotedRaiseBidWhenBudgetConstrained",
+		"PageOnePromotedRaiseBidWhenLowQualityScore",
+	},
+	Filterable: []string{"Id", "Name", "Status", "Type"},
+}
+
 // Get returns budgets matching a given selector and the total count of matching budgets.
 func (s *BiddingStrategyService) Get(selector Selector) ([]SharedBiddingStrategy, int64, error) {
+	if err := BiddingStrategyFields.Validate(selector); err != nil {
+		return nil, 0, err
+	}
 	selector.XMLName = xml.Name{Space: "", Local: "selector"}
 	respBody, err := s.Auth.request(
 		biddingStrategyServiceUrl,
@@ -75,7 +95,7 @@ func (s *BiddingStrategyService) Get(selector Selector) ([]SharedBiddingStrategy
 			Sel     Selector
 		}{
 			XMLName: xml.Name{
-				Space: baseUrl,
+				Space: s.Auth.namespace(biddingStrategyServiceUrl),
 				Local: "get",
 			},
 			Sel: selector,
@@ -95,6 +115,11 @@ func (s *BiddingStrategyService) Get(selector Selector) ([]SharedBiddingStrategy
 	return getResp.BiddingStrats, getResp.Size, err
 }
 
+// All returns a Pager over all the bidding strategies matching selector.
+func (s *BiddingStrategyService) All(selector Selector) *Pager[SharedBiddingStrategy] {
+	return NewPager(s.Get, selector)
+}
+
 // Mutate takes a budgetOperations and creates, modifies or destroys the associated budgets.
 func (s *BiddingStrategyService) Mutate(bidOperations BiddingStrategyOperations) ([]SharedBiddingStrategy, error) {
 	type bidStratOperation struct {
@@ -117,7 +142,7 @@ func (s *BiddingStrategyService) Mutate(bidOperations BiddingStrategyOperations)
 			Ops     []bidStratOperation `xml:"operations"`
 		}{
 			XMLName: xml.Name{
-				Space: baseUrl,
+				Space: s.Auth.namespace(biddingStrategyServiceUrl),
 				Local: "mutate",
 			},
 			Ops: operations,
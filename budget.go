@@ -36,8 +36,25 @@ func budgetError() (err error) {
 	return err
 }
 
+// BudgetFields lists the fields of the budgets the BudgetService selects and
+// filters on, see FieldCatalog.
+var BudgetFields = FieldCatalog{
+	Service: "BudgetService",
+	Selectable: []string{
+		"BudgetId", "BudgetName", "Amount", "DeliveryMethod", "BudgetReferenceCount",
+		"IsBudgetExplicitlyShared", "BudgetStatus",
+	},
+	Filterable: []string{
+		"BudgetId", "BudgetName", "Amount", "DeliveryMethod", "BudgetReferenceCount",
+		"IsBudgetExplicitlyShared", "BudgetStatus",
+	},
+}
+
 // Get returns budgets matching a given selector and the total count of matching budgets.
 func (s *BudgetService) Get(selector Selector) (budgets []Budget, totalCount int64, err error) {
+	if err := BudgetFields.Validate(selector); err != nil {
+		return budgets, totalCount, err
+	}
 	selector.XMLName = xml.Name{"", "selector"}
 	respBody, err := s.Auth.request(
 		budgetServiceUrl,
@@ -47,7 +64,7 @@ func (s *BudgetService) Get(selector Selector) (budgets []Budget, totalCount int
 			Sel     Selector
 		}{
 			XMLName: xml.Name{
-				Space: baseUrl,
+				Space: s.Auth.namespace(budgetServiceUrl),
 				Local: "get",
 			},
 			Sel: selector,
@@ -67,6 +84,11 @@ func (s *BudgetService) Get(selector Selector) (budgets []Budget, totalCount int
 	return getResp.Budgets, getResp.Size, err
 }
 
+// All returns a Pager over all the budgets matching selector.
+func (s *BudgetService) All(selector Selector) *Pager[Budget] {
+	return NewPager(s.Get, selector)
+}
+
 // Mutate takes a budgetOperations and creates, modifies or destroys the associated budgets.
 func (s *BudgetService) Mutate(budgetOperations BudgetOperations) (budgets []Budget, err error) {
 	type budgetOperation struct {
@@ -92,7 +114,7 @@ func (s *BudgetService) Mutate(budgetOperations BudgetOperations) (budgets []Bud
 			Ops     []budgetOperation `xml:"operations"`
 		}{
 			XMLName: xml.Name{
-				Space: baseUrl,
+				Space: s.Auth.namespace(budgetServiceUrl),
 				Local: "mutate",
 			},
 			Ops: operations,
@@ -1,8 +1,7 @@
 package gads
 
 import (
-//	"encoding/xml"
-//	"fmt"
+	"encoding/xml"
 )
 
 type BudgetOrderService struct {
@@ -12,3 +11,226 @@ type BudgetOrderService struct {
 func NewBudgetOrderService(auth *Auth) *BudgetOrderService {
 	return &BudgetOrderService{Auth: *auth}
 }
+
+// UnlimitedSpendingLimit is the spending limit of a budget order without
+// limit.
+const UnlimitedSpendingLimit int64 = -1
+
+// BillingAccount is a billing setup of a manager account, the budget
+// orders of its client accounts are invoiced to.
+type BillingAccount struct {
+	Id                 string `xml:"id"`
+	Name               string `xml:"name"`
+	CurrencyCode       string `xml:"currencyCode"`
+	PrimaryBillingId   string `xml:"primaryBillingId"`
+	SecondaryBillingId string `xml:"secondaryBillingId"`
+}
+
+// BudgetOrder is the amount an account may spend over a period, invoiced
+// to a billing account.
+//
+// SpendingLimit: micro amount, UnlimitedSpendingLimit without limit
+// TotalAdjustments: micro amount of the credits and debits of the order
+// StartDateTime, EndDateTime: "20060102 150405 Zone", see FormatDateTime
+// LastRequest: the last change of the order, pending until approved, see
+// Pending
+type BudgetOrder struct {
+	BillingAccountId   string              `xml:"billingAccountId,omitempty"`
+	Id                 int64               `xml:"id,omitempty"`
+	SpendingLimit      *int64              `xml:"spendingLimit>microAmount,omitempty"`
+	TotalAdjustments   *int64              `xml:"totalAdjustments>microAmount,omitempty"`
+	Name               string              `xml:"budgetOrderName,omitempty"`
+	PrimaryBillingId   string              `xml:"primaryBillingId,omitempty"`
+	SecondaryBillingId string              `xml:"secondaryBillingId,omitempty"`
+	PoNumber           string              `xml:"poNumber,omitempty"`
+	StartDateTime      string              `xml:"startDateTime,omitempty"`
+	EndDateTime        string              `xml:"endDateTime,omitempty"`
+	LastRequest        *BudgetOrderRequest `xml:"lastRequest,omitempty"`
+}
+
+// Pending tells if the last change of the order is still under review,
+// the order keeps its former values until it is approved.
+func (o BudgetOrder) Pending() bool {
+	return o.LastRequest != nil && o.LastRequest.Status == "UNDER_REVIEW"
+}
+
+// BudgetOrderRequest is a change of a budget order proposed for review.
+//
+// Status: UNDER_REVIEW, APPROVED, FAILED
+type BudgetOrderRequest struct {
+	Status        string `xml:"status"`
+	Name          string `xml:"budgetOrderName"`
+	SpendingLimit int64  `xml:"spendingLimit>microAmount"`
+	StartDateTime string `xml:"startDateTime"`
+	EndDateTime   string `xml:"endDateTime"`
+}
+
+// BudgetOrderOperations is a map of operations to perform on BudgetOrder's,
+// budget orders can be added and set.
+type BudgetOrderOperations map[string][]BudgetOrder
+
+// BudgetOrderFields lists the fields of the budget orders the
+// BudgetOrderService selects and filters on, see FieldCatalog.
+var BudgetOrderFields = FieldCatalog{
+	Service: "BudgetOrderService",
+	Selectable: []string{
+		"BillingAccountId", "BudgetOrderName", "EndDateTime", "Id", "LastRequestBudgetOrderName",
+		"LastRequestEndDateTime", "LastRequestSpendingLimit", "LastRequestStartDateTime",
+		"LastRequestStatus", "PoNumber", "PrimaryBillingId", "SecondaryBillingId", "SpendingLimit",
+		"StartDateTime", "TotalAdjustments",
+	},
+	Filterable: []string{
+		"BillingAccountId", "BudgetOrderName", "EndDateTime", "Id", "PoNumber", "PrimaryBillingId",
+		"SecondaryBillingId", "SpendingLimit", "StartDateTime",
+	},
+}
+
+// Get returns an array of BudgetOrder's and the total number of
+// BudgetOrder's matching the selector.
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/BudgetOrderService#get
+//
+func (s *BudgetOrderService) Get(selector Selector) (budgetOrders []BudgetOrder, totalCount int64, err error) {
+	if err := BudgetOrderFields.Validate(selector); err != nil {
+		return budgetOrders, totalCount, err
+	}
+	selector.XMLName = xml.Name{"", "serviceSelector"}
+	respBody, err := s.Auth.request(
+		budgetOrderServiceUrl,
+		"get",
+		struct {
+			XMLName xml.Name
+			Sel     Selector
+		}{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(budgetOrderServiceUrl),
+				Local: "get",
+			},
+			Sel: selector,
+		},
+	)
+	if err != nil {
+		return budgetOrders, totalCount, err
+	}
+	getResp := struct {
+		Size         int64         `xml:"rval>totalNumEntries"`
+		BudgetOrders []BudgetOrder `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return budgetOrders, totalCount, err
+	}
+	return getResp.BudgetOrders, getResp.Size, err
+}
+
+// All returns a Pager over all the budget orders matching selector.
+func (s *BudgetOrderService) All(selector Selector) *Pager[BudgetOrder] {
+	return NewPager(s.Get, selector)
+}
+
+// GetBillingAccounts returns the billing accounts of the manager account
+// the client accounts can be invoiced to.
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/BudgetOrderService#getbillingaccounts
+//
+func (s *BudgetOrderService) GetBillingAccounts() (billingAccounts []BillingAccount, err error) {
+	respBody, err := s.Auth.request(
+		budgetOrderServiceUrl,
+		"getBillingAccounts",
+		struct {
+			XMLName xml.Name
+		}{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(budgetOrderServiceUrl),
+				Local: "getBillingAccounts",
+			},
+		},
+	)
+	if err != nil {
+		return billingAccounts, err
+	}
+	getResp := struct {
+		BillingAccounts []BillingAccount `xml:"rval"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return billingAccounts, err
+	}
+	return getResp.BillingAccounts, err
+}
+
+// Mutate allows you to add budget orders and to propose changes of their
+// name, dates and spending limit, returning the modified budget orders.
+// The changes are reviewed, see BudgetOrder.Pending.
+//
+// Example
+//
+//   spendingLimit := int64(50000 * 1000000)
+//   budgetOrders, err := budgetOrderService.Mutate(
+//     gads.BudgetOrderOperations{
+//       "ADD": {
+//         gads.BudgetOrder{
+//           BillingAccountId: billingAccount.Id,
+//           Name:             "july 2018",
+//           PoNumber:         "PO-2018-07",
+//           StartDateTime:    "20180701 000000 Europe/Paris",
+//           EndDateTime:      "20180731 235959 Europe/Paris",
+//           SpendingLimit:    &spendingLimit,
+//         },
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/BudgetOrderService#mutate
+//
+func (s *BudgetOrderService) Mutate(budgetOrderOperations BudgetOrderOperations) (budgetOrders []BudgetOrder, err error) {
+	type budgetOrderOperation struct {
+		Action      cmOperator
+		BudgetOrder BudgetOrder `xml:"operand"`
+	}
+	operations := []budgetOrderOperation{}
+	for action, budgetOrders := range budgetOrderOperations {
+		for _, budgetOrder := range budgetOrders {
+			operations = append(operations,
+				budgetOrderOperation{
+					Action:      s.Auth.cmOperator(action),
+					BudgetOrder: budgetOrder,
+				},
+			)
+		}
+	}
+	mutation := struct {
+		XMLName xml.Name
+		Ops     []budgetOrderOperation `xml:"operations"`
+	}{
+		XMLName: xml.Name{
+			Space: s.Auth.namespace(budgetOrderServiceUrl),
+			Local: "mutate",
+		},
+		Ops: operations,
+	}
+	respBody, err := s.Auth.request(budgetOrderServiceUrl, "mutate", mutation)
+	if err != nil {
+		return budgetOrders, err
+	}
+	mutateResp := struct {
+		BaseResponse
+		BudgetOrders []BudgetOrder `xml:"rval>value"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &mutateResp)
+	if err != nil {
+		return budgetOrders, err
+	}
+
+	if len(mutateResp.PartialFailureErrors) > 0 {
+		err = mutateResp.PartialFailureErrors
+	}
+
+	return mutateResp.BudgetOrders, err
+}
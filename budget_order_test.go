@@ -0,0 +1,105 @@
+package gads
+
+import (
+	"strings"
+	"testing"
+)
+
+const testBillingAccount = `
+        <id>1234-5678-9012</id>
+        <name>finance</name>
+        <currencyCode>EUR</currencyCode>
+        <primaryBillingId>1111-2222-3333</primaryBillingId>
+        <secondaryBillingId>4444</secondaryBillingId>`
+
+const testBudgetOrderValue = `<value>
+          <billingAccountId>1234-5678-9012</billingAccountId>
+          <id>42</id>
+          <spendingLimit><microAmount>50000000000</microAmount></spendingLimit>
+          <totalAdjustments><microAmount>0</microAmount></totalAdjustments>
+          <budgetOrderName>july 2018</budgetOrderName>
+          <poNumber>PO-2018-07</poNumber>
+          <startDateTime>20180701 000000 Europe/Paris</startDateTime>
+          <endDateTime>20180731 235959 Europe/Paris</endDateTime>
+          <lastRequest>
+            <status>UNDER_REVIEW</status>
+            <budgetOrderName>july 2018</budgetOrderName>
+            <spendingLimit><microAmount>60000000000</microAmount></spendingLimit>
+            <startDateTime>20180701 000000 Europe/Paris</startDateTime>
+            <endDateTime>20180731 235959 Europe/Paris</endDateTime>
+          </lastRequest>
+        </value>`
+
+func TestBudgetOrder(t *testing.T) {
+	var requests []testRequest
+	auth, cleanup := testServiceAuth(t, &requests, func(request testRequest) string {
+		if request.Action == "getBillingAccounts" {
+			return testBillingAccount
+		}
+		return testBudgetOrderValue
+	})
+	defer cleanup()
+
+	service := NewBudgetOrderService(&auth)
+	billingAccounts, err := service.GetBillingAccounts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedAccount := BillingAccount{
+		Id:                 "1234-5678-9012",
+		Name:               "finance",
+		CurrencyCode:       "EUR",
+		PrimaryBillingId:   "1111-2222-3333",
+		SecondaryBillingId: "4444",
+	}
+	if len(billingAccounts) != 1 || billingAccounts[0] != expectedAccount {
+		t.Errorf("expected the billing account %#v, got %#v", expectedAccount, billingAccounts)
+	}
+
+	spendingLimit := int64(50000000000)
+	budgetOrders, err := service.Mutate(BudgetOrderOperations{
+		"ADD": {{
+			BillingAccountId: billingAccounts[0].Id,
+			Name:             "july 2018",
+			PoNumber:         "PO-2018-07",
+			StartDateTime:    "20180701 000000 Europe/Paris",
+			EndDateTime:      "20180731 235959 Europe/Paris",
+			SpendingLimit:    &spendingLimit,
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(budgetOrders) != 1 || budgetOrders[0].Id != 42 || *budgetOrders[0].SpendingLimit != spendingLimit ||
+		!budgetOrders[0].Pending() || budgetOrders[0].LastRequest.SpendingLimit != 60000000000 {
+		t.Errorf("unexpected budget orders %#v", budgetOrders)
+	}
+
+	for _, request := range requests {
+		if !strings.Contains(request.Body, `xmlns="https://adwords.google.com/api/adwords/billing/v201806"`) {
+			t.Errorf("expected the %s request in the billing namespace\n%s", request.Action, request.Body)
+		}
+	}
+	for _, expected := range []string{
+		`<operator xmlns="https://adwords.google.com/api/adwords/cm/v201806">ADD</operator>`,
+		"<spendingLimit>\n",
+		"<microAmount>50000000000</microAmount>",
+		"<poNumber>PO-2018-07</poNumber>",
+	} {
+		if !strings.Contains(requests[1].Body, expected) {
+			t.Errorf("expected %s in the mutation\n%s", expected, requests[1].Body)
+		}
+	}
+	// the elements follow the sequence of the BudgetOrder type of the api
+	offset := 0
+	for _, element := range []string{"billingAccountId", "spendingLimit", "budgetOrderName", "poNumber", "startDateTime", "endDateTime"} {
+		i := strings.Index(requests[1].Body[offset:], "<"+element+">")
+		if i < 0 {
+			t.Fatalf("expected %s after the previous elements in the mutation\n%s", element, requests[1].Body)
+		}
+		offset += i
+	}
+	if strings.Contains(requests[1].Body, "totalAdjustments") || strings.Contains(requests[1].Body, "lastRequest") {
+		t.Errorf("expected the read only fields to be omitted\n%s", requests[1].Body)
+	}
+}
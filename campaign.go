@@ -69,7 +69,7 @@ func NewDynamicSearchAdsSetting(domainName, languageCode string) CampaignSetting
 
 func NewGeoTargetTypeSetting(positiveGeoTargetType, negativeGeoTargetType string) CampaignSetting {
 	return CampaignSetting{
-		Type: "GeoTargetTypeSetting",
+		Type:                  "GeoTargetTypeSetting",
 		PositiveGeoTargetType: &positiveGeoTargetType,
 		NegativeGeoTargetType: &negativeGeoTargetType,
 	}
@@ -276,6 +276,33 @@ type CampaignLabel struct {
 
 type CampaignLabelOperations map[string][]CampaignLabel
 
+// CampaignFields lists the fields of the campaigns the CampaignService
+// selects and filters on, see FieldCatalog.
+var CampaignFields = FieldCatalog{
+	Service: "CampaignService",
+	Selectable: []string{
+		"Id", "Name", "Status", "ServingStatus", "StartDate", "EndDate", "BudgetId", "BudgetName",
+		"Amount", "DeliveryMethod", "BudgetReferenceCount", "IsBudgetExplicitlyShared",
+		"BudgetStatus", "AdServingOptimizationStatus", "FrequencyCapMaxImpressions", "TimeUnit",
+		"Level", "Settings", "AdvertisingChannelType", "AdvertisingChannelSubType", "Labels",
+		"BiddingStrategyId", "BiddingStrategyName", "BiddingStrategyType", "BiddingStrategyGoalType",
+		"EnhancedCpcEnabled", "TargetCpa", "TargetRoas", "TargetGoogleSearch", "TargetSearchNetwork",
+		"TargetContentNetwork", "TargetPartnerSearchNetwork", "TrackingUrlTemplate",
+		"FinalUrlSuffix", "UrlCustomParameters", "CampaignTrialType", "BaseCampaignId",
+		"CampaignGroupId", "Eligible", "RejectionReasons", "SelectiveOptimization",
+	},
+	Filterable: []string{
+		"Id", "Name", "Status", "ServingStatus", "StartDate", "EndDate", "BudgetId", "BudgetName",
+		"Amount", "DeliveryMethod", "BudgetReferenceCount", "IsBudgetExplicitlyShared",
+		"BudgetStatus", "FrequencyCapMaxImpressions", "TimeUnit", "Level", "AdvertisingChannelType",
+		"AdvertisingChannelSubType", "Labels", "BiddingStrategyId", "BiddingStrategyName",
+		"BiddingStrategyType", "BiddingStrategyGoalType", "EnhancedCpcEnabled", "TargetCpa",
+		"TargetRoas", "TargetGoogleSearch", "TargetSearchNetwork", "TargetContentNetwork",
+		"TargetPartnerSearchNetwork", "TrackingUrlTemplate", "FinalUrlSuffix", "CampaignTrialType",
+		"BaseCampaignId", "CampaignGroupId",
+	},
+}
+
 // Get returns an array of Campaign's and the total number of campaign's matching
 // the selector.
 //
@@ -310,6 +337,9 @@ type CampaignLabelOperations map[string][]CampaignLabel
 //     https://developers.google.com/adwords/api/docs/reference/v201806/CampaignService#get
 //
 func (s *CampaignService) Get(selector Selector) (campaigns []Campaign, totalCount int64, err error) {
+	if err := CampaignFields.Validate(selector); err != nil {
+		return campaigns, totalCount, err
+	}
 	selector.XMLName = xml.Name{"", "serviceSelector"}
 	respBody, err := s.Auth.request(
 		campaignServiceUrl,
@@ -319,7 +349,7 @@ func (s *CampaignService) Get(selector Selector) (campaigns []Campaign, totalCou
 			Sel     Selector
 		}{
 			XMLName: xml.Name{
-				Space: baseUrl,
+				Space: s.Auth.namespace(campaignServiceUrl),
 				Local: "get",
 			},
 			Sel: selector,
@@ -339,6 +369,11 @@ func (s *CampaignService) Get(selector Selector) (campaigns []Campaign, totalCou
 	return getResp.Campaigns, getResp.Size, err
 }
 
+// All returns a Pager over all the campaigns matching selector.
+func (s *CampaignService) All(selector Selector) *Pager[Campaign] {
+	return NewPager(s.Get, selector)
+}
+
 // Mutate allows you to add and modify campaigns, returning the
 // campaigns.  Note that the "REMOVE" operator is not supported.
 // To remove a campaign set its Status to "REMOVED".
@@ -404,7 +439,7 @@ func (s *CampaignService) Mutate(campaignOperations CampaignOperations) (campaig
 		Ops     []campaignOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
-			Space: baseUrl,
+			Space: s.Auth.namespace(campaignServiceUrl),
 			Local: "mutate",
 		},
 		Ops: operations}
@@ -468,7 +503,7 @@ func (s *CampaignService) MutateLabel(campaignLabelOperations CampaignLabelOpera
 		Ops     []campaignLabelOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
-			Space: baseUrl,
+			Space: s.Auth.namespace(campaignServiceUrl),
 			Local: "mutateLabel",
 		},
 		Ops: operations}
@@ -492,12 +527,35 @@ func (s *CampaignService) MutateLabel(campaignLabelOperations CampaignLabelOpera
 	return mutateResp.CampaignLabels, err
 }
 
-// Query is not yet implemented
+// Query returns the campaigns matching the awql query, and their
+// total count regardless of the LIMIT clause.
 //
 // Relevant documentation
 //
 //     https://developers.google.com/adwords/api/docs/reference/v201806/CampaignService#query
 //
 func (s *CampaignService) Query(query string) (campaigns []Campaign, totalCount int64, err error) {
-	return campaigns, totalCount, ERROR_NOT_YET_IMPLEMENTED
+	respBody, err := s.Auth.request(
+		campaignServiceUrl,
+		"query",
+		AWQLQuery{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(campaignServiceUrl),
+				Local: "query",
+			},
+			Query: query,
+		},
+	)
+	if err != nil {
+		return campaigns, totalCount, err
+	}
+	queryResp := struct {
+		Size      int64      `xml:"rval>totalNumEntries"`
+		Campaigns []Campaign `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &queryResp)
+	if err != nil {
+		return campaigns, totalCount, err
+	}
+	return queryResp.Campaigns, queryResp.Size, err
 }
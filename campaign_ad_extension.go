@@ -1,5 +1,16 @@
 package gads
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// CampaignAdExtensionService manages the legacy ad extensions of the
+// campaigns, superseded by the feed based extensions of the
+// CampaignExtensionSettingService. It is only served up to the api version
+// CampaignAdExtensionVersion, set with Auth.APIVersion, and is mostly used
+// to inventory the legacy extensions and migrate them, see
+// CampaignAdExtension.ExtensionSetting.
 type CampaignAdExtensionService struct {
 	Auth
 }
@@ -7,3 +18,327 @@ type CampaignAdExtensionService struct {
 func NewCampaignAdExtensionService(auth *Auth) *CampaignAdExtensionService {
 	return &CampaignAdExtensionService{Auth: *auth}
 }
+
+// CampaignAdExtensionVersion is the last api version serving the
+// CampaignAdExtensionService.
+const CampaignAdExtensionVersion = "v201409"
+
+// checkVersion fails when the api version of the Auth no longer serves the
+// service, rather than posting requests the api rejects.
+func (s *CampaignAdExtensionService) checkVersion() error {
+	if version := s.Auth.version(); version > CampaignAdExtensionVersion {
+		return fmt.Errorf("CampaignAdExtensionService is not served by the api %s, set Auth.APIVersion to %s", version, CampaignAdExtensionVersion)
+	}
+	return nil
+}
+
+// AdExtension is a legacy ad extension: LocationExtension,
+// LocationSyncExtension or MobileExtension.
+type AdExtension interface {
+	GetID() int64
+	GetType() string
+}
+
+// LocationExtension is the address of a business shown with the ads.
+//
+// Source: ADWORDS_FRONTEND, GOOGLE_MY_BUSINESS
+type LocationExtension struct {
+	Type            string    `xml:"xsi:type,attr,omitempty"`
+	Id              int64     `xml:"id,omitempty"`
+	Address         Address   `xml:"address"`
+	GeoPoint        *GeoPoint `xml:"geoPoint,omitempty"`
+	EncodedLocation string    `xml:"encodedLocation,omitempty"`
+	CompanyName     string    `xml:"companyName,omitempty"`
+	PhoneNumber     string    `xml:"phoneNumber,omitempty"`
+	Source          string    `xml:"source,omitempty"`
+	IconMediaId     int64     `xml:"iconMediaId,omitempty"`
+	ImageMediaId    int64     `xml:"imageMediaId,omitempty"`
+}
+
+func (e LocationExtension) GetID() int64 {
+	return e.Id
+}
+
+func (e LocationExtension) GetType() string {
+	return "LocationExtension"
+}
+
+// LocationSyncExtension shows the addresses of a Google My Business
+// account with the ads.
+type LocationSyncExtension struct {
+	Type          string     `xml:"xsi:type,attr,omitempty"`
+	Id            int64      `xml:"id,omitempty"`
+	Email         string     `xml:"email,omitempty"`
+	AuthToken     string     `xml:"authToken,omitempty"`
+	OAuthInfo     *OAuthInfo `xml:"oAuthInfo,omitempty"`
+	IconMediaId   int64      `xml:"iconMediaId,omitempty"`
+	ShouldSyncUrl bool       `xml:"shouldSyncUrl,omitempty"`
+}
+
+func (e LocationSyncExtension) GetID() int64 {
+	return e.Id
+}
+
+func (e LocationSyncExtension) GetType() string {
+	return "LocationSyncExtension"
+}
+
+// MobileExtension is the legacy call extension, a phone number shown with
+// the ads.
+type MobileExtension struct {
+	Type           string `xml:"xsi:type,attr,omitempty"`
+	Id             int64  `xml:"id,omitempty"`
+	PhoneNumber    string `xml:"phoneNumber"`
+	CountryCode    string `xml:"countryCode"`
+	IsCallTracking bool   `xml:"isCallTracking"`
+}
+
+func (e MobileExtension) GetID() int64 {
+	return e.Id
+}
+
+func (e MobileExtension) GetType() string {
+	return "MobileExtension"
+}
+
+func adExtensionUnmarshalXML(dec *xml.Decoder, start xml.StartElement) (AdExtension, error) {
+	adExtensionType, err := findAttr(start.Attr, xml.Name{Space: "http://www.w3.org/2001/XMLSchema-instance", Local: "type"})
+	if err != nil {
+		return nil, err
+	}
+	switch adExtensionType {
+	case "LocationExtension":
+		e := LocationExtension{}
+		err := dec.DecodeElement(&e, &start)
+		e.Type = adExtensionType
+		return e, err
+	case "LocationSyncExtension":
+		e := LocationSyncExtension{}
+		err := dec.DecodeElement(&e, &start)
+		e.Type = adExtensionType
+		return e, err
+	case "MobileExtension":
+		e := MobileExtension{}
+		err := dec.DecodeElement(&e, &start)
+		e.Type = adExtensionType
+		return e, err
+	default:
+		if StrictMode {
+			return nil, fmt.Errorf("unknown ad extension type %#v", adExtensionType)
+		}
+		return nil, dec.Skip()
+	}
+}
+
+// CampaignAdExtension is a legacy ad extension of a campaign.
+//
+// Status: ACTIVE, DELETED
+// ApprovalStatus: APPROVED, UNCHECKED, DISAPPROVED
+type CampaignAdExtension struct {
+	CampaignId     int64       `xml:"campaignId"`
+	AdExtension    AdExtension `xml:"adExtension"`
+	Status         string      `xml:"status,omitempty"`
+	ApprovalStatus string      `xml:"approvalStatus,omitempty"`
+}
+
+func (c *CampaignAdExtension) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch start := token.(type) {
+		case xml.StartElement:
+			switch start.Name.Local {
+			case "campaignId":
+				err = dec.DecodeElement(&c.CampaignId, &start)
+			case "adExtension":
+				c.AdExtension, err = adExtensionUnmarshalXML(dec, start)
+			case "status":
+				err = dec.DecodeElement(&c.Status, &start)
+			case "approvalStatus":
+				err = dec.DecodeElement(&c.ApprovalStatus, &start)
+			default:
+				err = dec.Skip()
+			}
+			if err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// ExtensionSetting returns the feed based extension setting replacing a
+// legacy call extension, to add with the CampaignExtensionSettingService
+// before removing the legacy one. The location extensions have no
+// extension setting, their addresses are served from a location feed
+// synchronized with Google My Business, see PlacesLocationFeedData.
+//
+// Example
+//
+//   setting, err := campaignAdExtension.ExtensionSetting()
+//   if err == nil {
+//     _, err = campaignExtensionSettingService.Mutate(
+//       gads.CampaignExtensionSettingOperations{"ADD": {setting}},
+//     )
+//   }
+//
+func (c CampaignAdExtension) ExtensionSetting() (setting CampaignExtensionSetting, err error) {
+	mobile, ok := c.AdExtension.(MobileExtension)
+	if !ok {
+		return setting, fmt.Errorf("no extension setting for the ad extension %T of the campaign %d", c.AdExtension, c.CampaignId)
+	}
+	callTracking := mobile.IsCallTracking
+	return CampaignExtensionSetting{
+		CampaignID:    c.CampaignId,
+		ExtensionType: "CALL",
+		ExtensionSetting: &ExtensionSetting{
+			Extensions: ExtensionFeedItems{
+				CallFeedItem{
+					CommonExtensionFeedItem: &CommonExtensionFeedItem{Type: "CallFeedItem"},
+					PhoneNumber:             mobile.PhoneNumber,
+					CountryCode:             mobile.CountryCode,
+					CallTracking:            &callTracking,
+				},
+			},
+			PlateformRestrictions: "NONE",
+		},
+	}, nil
+}
+
+// CampaignAdExtensionOperations is a map of operations to perform on
+// CampaignAdExtension's, they can be added, set and removed.
+type CampaignAdExtensionOperations map[string][]CampaignAdExtension
+
+// CampaignAdExtensionFields lists the fields of the campaign ad extensions
+// the CampaignAdExtensionService selects and filters on, see FieldCatalog.
+var CampaignAdExtensionFields = FieldCatalog{
+	Service: "CampaignAdExtensionService",
+	Selectable: []string{
+		"AdExtensionId", "CampaignId", "Status", "ApprovalStatus", "LocationExtensionAddress",
+		"LocationExtensionGeoPoint", "LocationExtensionEncodedLocation",
+		"LocationExtensionCompanyName", "LocationExtensionPhoneNumber", "LocationExtensionSource",
+		"MobileExtensionPhoneNumber", "MobileExtensionCountryCode", "MobileExtensionIsCallTracking",
+	},
+	Filterable: []string{
+		"AdExtensionId", "CampaignId", "Status", "ApprovalStatus", "LocationExtensionSource",
+	},
+}
+
+// Get returns an array of CampaignAdExtension's and the total number of
+// CampaignAdExtension's matching the selector.
+//
+// Example
+//
+//   campaignAdExtensions, totalCount, err := campaignAdExtensionService.Get(
+//     gads.Selector{
+//       Fields: gads.CampaignAdExtensionFields.Selectable,
+//       Predicates: []gads.Predicate{
+//         {"Status", "EQUALS", []string{"ACTIVE"}},
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201409/CampaignAdExtensionService#get
+//
+func (s *CampaignAdExtensionService) Get(selector Selector) (campaignAdExtensions []CampaignAdExtension, totalCount int64, err error) {
+	if err := CampaignAdExtensionFields.Validate(selector); err != nil {
+		return campaignAdExtensions, totalCount, err
+	}
+	if err := s.checkVersion(); err != nil {
+		return campaignAdExtensions, totalCount, err
+	}
+	selector.XMLName = xml.Name{"", "serviceSelector"}
+	respBody, err := s.Auth.request(
+		campaignAdExtensionServiceUrl,
+		"get",
+		struct {
+			XMLName xml.Name
+			Sel     Selector
+		}{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(campaignAdExtensionServiceUrl),
+				Local: "get",
+			},
+			Sel: selector,
+		},
+	)
+	if err != nil {
+		return campaignAdExtensions, totalCount, err
+	}
+	getResp := struct {
+		Size                 int64                 `xml:"rval>totalNumEntries"`
+		CampaignAdExtensions []CampaignAdExtension `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return campaignAdExtensions, totalCount, err
+	}
+	return getResp.CampaignAdExtensions, getResp.Size, err
+}
+
+// All returns a Pager over all the campaign ad extensions matching
+// selector.
+func (s *CampaignAdExtensionService) All(selector Selector) *Pager[CampaignAdExtension] {
+	return NewPager(s.Get, selector)
+}
+
+// Mutate allows you to add, set and remove the legacy ad extensions of
+// campaigns, returning the modified ones.
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201409/CampaignAdExtensionService#mutate
+//
+func (s *CampaignAdExtensionService) Mutate(campaignAdExtensionOperations CampaignAdExtensionOperations) (campaignAdExtensions []CampaignAdExtension, err error) {
+	if err := s.checkVersion(); err != nil {
+		return campaignAdExtensions, err
+	}
+	type campaignAdExtensionOperation struct {
+		Action              string              `xml:"operator"`
+		CampaignAdExtension CampaignAdExtension `xml:"operand"`
+	}
+	operations := []campaignAdExtensionOperation{}
+	for action, campaignAdExtensions := range campaignAdExtensionOperations {
+		for _, campaignAdExtension := range campaignAdExtensions {
+			operations = append(operations,
+				campaignAdExtensionOperation{
+					Action:              action,
+					CampaignAdExtension: campaignAdExtension,
+				},
+			)
+		}
+	}
+	mutation := struct {
+		XMLName xml.Name
+		Ops     []campaignAdExtensionOperation `xml:"operations"`
+	}{
+		XMLName: xml.Name{
+			Space: s.Auth.namespace(campaignAdExtensionServiceUrl),
+			Local: "mutate",
+		},
+		Ops: operations,
+	}
+	respBody, err := s.Auth.request(campaignAdExtensionServiceUrl, "mutate", mutation)
+	if err != nil {
+		return campaignAdExtensions, err
+	}
+	mutateResp := struct {
+		BaseResponse
+		CampaignAdExtensions []CampaignAdExtension `xml:"rval>value"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &mutateResp)
+	if err != nil {
+		return campaignAdExtensions, err
+	}
+
+	if len(mutateResp.PartialFailureErrors) > 0 {
+		err = mutateResp.PartialFailureErrors
+	}
+
+	return mutateResp.CampaignAdExtensions, err
+}
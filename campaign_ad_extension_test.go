@@ -0,0 +1,134 @@
+package gads
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testCampaignAdExtensionEntries = `
+        <totalNumEntries>4</totalNumEntries>
+        <entries>
+          <campaignId>1</campaignId>
+          <adExtension xsi:type="LocationExtension">
+            <id>11</id>
+            <AdExtension.Type>LocationExtension</AdExtension.Type>
+            <address>
+              <streetAddress>1600 Amphitheatre Pkwy</streetAddress>
+              <cityName>Mountain View</cityName>
+              <countryCode>US</countryCode>
+            </address>
+            <geoPoint>
+              <latitudeInMicroDegrees>37421759</latitudeInMicroDegrees>
+              <longitudeInMicroDegrees>-122084057</longitudeInMicroDegrees>
+            </geoPoint>
+            <companyName>Google</companyName>
+            <source>ADWORDS_FRONTEND</source>
+          </adExtension>
+          <status>ACTIVE</status>
+          <approvalStatus>APPROVED</approvalStatus>
+        </entries>
+        <entries>
+          <campaignId>1</campaignId>
+          <adExtension xsi:type="LocationSyncExtension">
+            <id>12</id>
+            <email>stores@example.com</email>
+            <shouldSyncUrl>true</shouldSyncUrl>
+          </adExtension>
+          <status>ACTIVE</status>
+        </entries>
+        <entries>
+          <campaignId>2</campaignId>
+          <adExtension xsi:type="MobileExtension">
+            <id>13</id>
+            <phoneNumber>650-253-0000</phoneNumber>
+            <countryCode>US</countryCode>
+            <isCallTracking>true</isCallTracking>
+          </adExtension>
+          <status>ACTIVE</status>
+        </entries>
+        <entries>
+          <campaignId>2</campaignId>
+          <adExtension xsi:type="DomainInfoExtension"><id>14</id></adExtension>
+          <status>ACTIVE</status>
+        </entries>`
+
+func TestCampaignAdExtension(t *testing.T) {
+	var requests []testRequest
+	auth, cleanup := testServiceAuth(t, &requests, func(request testRequest) string {
+		if request.Action == "get" {
+			return testCampaignAdExtensionEntries
+		}
+		return ""
+	})
+	defer cleanup()
+
+	if _, _, err := NewCampaignAdExtensionService(&auth).Get(Selector{Fields: []string{"CampaignId"}}); err == nil || len(requests) > 0 {
+		t.Fatalf("expected the service to be refused with the default api version, got %v", err)
+	}
+
+	auth.APIVersion = CampaignAdExtensionVersion
+	service := NewCampaignAdExtensionService(&auth)
+	campaignAdExtensions, totalCount, err := service.Get(Selector{Fields: CampaignAdExtensionFields.Selectable})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if totalCount != 4 || len(campaignAdExtensions) != 4 {
+		t.Fatalf("expected 4 campaign ad extensions, got %d of %d", len(campaignAdExtensions), totalCount)
+	}
+	expected := []CampaignAdExtension{
+		{
+			CampaignId: 1,
+			AdExtension: LocationExtension{
+				Type:        "LocationExtension",
+				Id:          11,
+				Address:     Address{StreetAddress: "1600 Amphitheatre Pkwy", CityName: "Mountain View", CountryCode: "US"},
+				GeoPoint:    &GeoPoint{Latitude: 37421759, Longitude: -122084057},
+				CompanyName: "Google",
+				Source:      "ADWORDS_FRONTEND",
+			},
+			Status:         "ACTIVE",
+			ApprovalStatus: "APPROVED",
+		},
+		{
+			CampaignId:  1,
+			AdExtension: LocationSyncExtension{Type: "LocationSyncExtension", Id: 12, Email: "stores@example.com", ShouldSyncUrl: true},
+			Status:      "ACTIVE",
+		},
+		{
+			CampaignId:  2,
+			AdExtension: MobileExtension{Type: "MobileExtension", Id: 13, PhoneNumber: "650-253-0000", CountryCode: "US", IsCallTracking: true},
+			Status:      "ACTIVE",
+		},
+		{CampaignId: 2, Status: "ACTIVE"},
+	}
+	if !reflect.DeepEqual(campaignAdExtensions, expected) {
+		t.Errorf("expected\n%#v\ngot\n%#v", expected, campaignAdExtensions)
+	}
+
+	if _, err := campaignAdExtensions[0].ExtensionSetting(); err == nil {
+		t.Error("expected no extension setting for a location extension")
+	}
+	setting, err := campaignAdExtensions[2].ExtensionSetting()
+	if err != nil {
+		t.Fatal(err)
+	}
+	call, ok := setting.ExtensionSetting.Extensions[0].(CallFeedItem)
+	if setting.CampaignID != 2 || setting.ExtensionType != "CALL" || !ok || call.PhoneNumber != "650-253-0000" || !*call.CallTracking {
+		t.Errorf("unexpected extension setting %#v", setting)
+	}
+
+	if _, err := service.Mutate(CampaignAdExtensionOperations{"REMOVE": campaignAdExtensions[2:3]}); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		`<mutate xmlns="https://adwords.google.com/api/adwords/cm/v201409">`,
+		"<operator>REMOVE</operator>",
+		`<adExtension xsi:type="MobileExtension">`,
+		"<id>13</id>",
+	} {
+		if !strings.Contains(requests[1].Body, expected) {
+			t.Errorf("expected %s in the mutation\n%s", expected, requests[1].Body)
+		}
+	}
+}
@@ -199,7 +199,38 @@ func NewCampaignCriterionService(auth *Auth) *CampaignCriterionService {
 	return &CampaignCriterionService{Auth: *auth}
 }
 
+// CampaignCriterionFields lists the fields of the campaign criteria the
+// CampaignCriterionService selects and filters on, see FieldCatalog.
+var CampaignCriterionFields = FieldCatalog{
+	Service: "CampaignCriterionService",
+	Selectable: []string{
+		"Id", "CampaignId", "CampaignName", "CriteriaType", "IsNegative", "BidModifier",
+		"CampaignCriterionStatus", "BaseCampaignId", "KeywordText", "KeywordMatchType",
+		"LocationName", "DisplayType", "TargetingStatus", "ParentLocations", "LanguageCode",
+		"LanguageName", "PlatformName", "AgeRangeType", "GenderType", "IncomeRangeType",
+		"ParentType", "UserListId", "UserListName", "UserListMembershipStatus", "UserInterestId",
+		"UserInterestName", "PlacementUrl", "Address", "GeoPoint", "RadiusDistanceUnits",
+		"RadiusInUnits", "DayOfWeek", "StartHour", "StartMinute", "EndHour", "EndMinute",
+		"IpAddress", "OperatingSystemName", "OsMajorVersion", "OsMinorVersion", "OperatorType",
+		"CarrierName", "CountryCode", "DeviceName", "ManufacturerName", "DeviceType",
+		"ContentLabelType", "MobileAppCategoryId", "DisplayName", "AppId", "VerticalId",
+		"VerticalParentId", "Path", "Parameter", "CriteriaCoverage", "CriteriaSamples", "FeedId",
+		"MatchingFunction", "YouTubeChannelId", "ChannelName", "VideoId", "VideoName",
+	},
+	Filterable: []string{
+		"Id", "CampaignId", "CampaignName", "CriteriaType", "IsNegative", "BidModifier",
+		"CampaignCriterionStatus", "BaseCampaignId", "KeywordText", "KeywordMatchType",
+		"LocationName", "DisplayType", "TargetingStatus", "LanguageCode", "LanguageName",
+		"PlatformName", "UserListMembershipStatus", "PlacementUrl", "ContentLabelType",
+		"DisplayName", "OperatingSystemName", "CarrierName", "DeviceName", "ManufacturerName",
+		"DeviceType",
+	},
+}
+
 func (s *CampaignCriterionService) Get(selector Selector) (campaignCriterions CampaignCriterions, totalCount int64, err error) {
+	if err := CampaignCriterionFields.Validate(selector); err != nil {
+		return campaignCriterions, totalCount, err
+	}
 	selector.XMLName = xml.Name{"", "serviceSelector"}
 	respBody, err := s.Auth.request(
 		campaignCriterionServiceUrl,
@@ -209,7 +240,7 @@ func (s *CampaignCriterionService) Get(selector Selector) (campaignCriterions Ca
 			Sel     Selector
 		}{
 			XMLName: xml.Name{
-				Space: baseUrl,
+				Space: s.Auth.namespace(campaignCriterionServiceUrl),
 				Local: "get",
 			},
 			Sel: selector,
@@ -230,6 +261,11 @@ func (s *CampaignCriterionService) Get(selector Selector) (campaignCriterions Ca
 	return getResp.CampaignCriterions, getResp.Size, err
 }
 
+// All returns a Pager over all the campaign criteria matching selector.
+func (s *CampaignCriterionService) All(selector Selector) *Pager[interface{}] {
+	return NewPager(s.Get, selector)
+}
+
 func (s *CampaignCriterionService) Mutate(campaignCriterionOperations CampaignCriterionOperations) (campaignCriterions CampaignCriterions, err error) {
 	type campaignCriterionOperation struct {
 		Action            string      `xml:"operator"`
@@ -251,7 +287,7 @@ func (s *CampaignCriterionService) Mutate(campaignCriterionOperations CampaignCr
 		Ops     []campaignCriterionOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
-			Space: baseUrl,
+			Space: s.Auth.namespace(campaignCriterionServiceUrl),
 			Local: "mutate",
 		},
 		Ops: operations,
@@ -305,6 +341,35 @@ func (s *CampaignCriterionService) Mutate(campaignCriterionOperations CampaignCr
 	return mutateResp.CampaignCriterions, err
 }
 
-func (s *CampaignCriterionService) Query(query string) (campaignCriterions CampaignCriterions, err error) {
-	return campaignCriterions, ERROR_NOT_YET_IMPLEMENTED
+// Query returns the campaign criteria matching the awql query, and their
+// total count regardless of the LIMIT clause.
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/CampaignCriterionService#query
+//
+func (s *CampaignCriterionService) Query(query string) (campaignCriterions CampaignCriterions, totalCount int64, err error) {
+	respBody, err := s.Auth.request(
+		campaignCriterionServiceUrl,
+		"query",
+		AWQLQuery{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(campaignCriterionServiceUrl),
+				Local: "query",
+			},
+			Query: query,
+		},
+	)
+	if err != nil {
+		return campaignCriterions, totalCount, err
+	}
+	queryResp := struct {
+		Size               int64              `xml:"rval>totalNumEntries"`
+		CampaignCriterions CampaignCriterions `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &queryResp)
+	if err != nil {
+		return campaignCriterions, totalCount, err
+	}
+	return queryResp.CampaignCriterions, queryResp.Size, err
 }
@@ -59,6 +59,14 @@ func (ex *ExtensionFeedItems) UnmarshalXML(dec *xml.Decoder, start xml.StartElem
 		}
 		slfi.Type = "SitelinkFeedItem"
 		*ex = append(*ex, slfi)
+	case "CallFeedItem":
+		cfi := CallFeedItem{CommonExtensionFeedItem: &CommonExtensionFeedItem{}}
+		err := dec.DecodeElement(&cfi, &start)
+		if err != nil {
+			return err
+		}
+		cfi.Type = "CallFeedItem"
+		*ex = append(*ex, cfi)
 	default:
 		if StrictMode {
 			return fmt.Errorf("unknown feed item type -> %#v", feedItemType)
@@ -90,6 +98,15 @@ func NewCampaignExtensionSettingService(auth *Auth) *CampaignExtensionSettingSer
 	return &CampaignExtensionSettingService{Auth: *auth}
 }
 
+// CampaignExtensionSettingFields lists the fields of the campaign extension
+// settings the CampaignExtensionSettingService selects and filters on, see
+// FieldCatalog.
+var CampaignExtensionSettingFields = FieldCatalog{
+	Service:    "CampaignExtensionSettingService",
+	Selectable: []string{"CampaignId", "ExtensionType", "Extensions", "PlatformRestrictions"},
+	Filterable: []string{"CampaignId", "ExtensionType"},
+}
+
 // Get returns an array of CampaignExtensionSettings' and
 // the total number of CampaignExtensionSettings' matching the selector.
 //
@@ -99,6 +116,9 @@ func (s *CampaignExtensionSettingService) Get(selector Selector) (
 	totalCount int64,
 	err error,
 ) {
+	if err := CampaignExtensionSettingFields.Validate(selector); err != nil {
+		return extensionSettings, totalCount, err
+	}
 	selector.XMLName = xml.Name{"", "selector"}
 	respBody, err := s.Auth.request(
 		campaignExtensionSettingServiceUrl,
@@ -108,7 +128,7 @@ func (s *CampaignExtensionSettingService) Get(selector Selector) (
 			Sel     Selector
 		}{
 			XMLName: xml.Name{
-				Space: baseUrl,
+				Space: s.Auth.namespace(campaignExtensionSettingServiceUrl),
 				Local: "get",
 			},
 			Sel: selector,
@@ -130,6 +150,11 @@ func (s *CampaignExtensionSettingService) Get(selector Selector) (
 
 }
 
+// All returns a Pager over all the campaign extension settings matching selector.
+func (s *CampaignExtensionSettingService) All(selector Selector) *Pager[CampaignExtensionSetting] {
+	return NewPager(s.Get, selector)
+}
+
 // Mutate allows you to add, modify and remove CampaignExtensionSetting, returning the
 // modified ones.
 //
@@ -147,7 +172,7 @@ func (s *CampaignExtensionSettingService) Mutate(
 			operations = append(
 				operations,
 				operation{
-					Action: action,
+					Action:                   action,
 					CampaignExtensionSetting: campaignExtensionSetting,
 				},
 			)
@@ -158,7 +183,7 @@ func (s *CampaignExtensionSettingService) Mutate(
 		Ops     []operation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
-			Space: baseUrl,
+			Space: s.Auth.namespace(campaignExtensionSettingServiceUrl),
 			Local: "mutate",
 		},
 		Ops: operations,
@@ -187,36 +212,35 @@ func (s *CampaignExtensionSettingService) Mutate(
 	return mutateResp.CampaignExtensionSettings, err
 }
 
-// Query allows to use AWQL to Get CampaignExtensionSettings matching
-// the query
+// Query returns the campaign extension settings matching the awql query,
+// and their total count regardless of the LIMIT clause.
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/CampaignExtensionSettingService#query
 //
-// see https://developers.google.com/adwords/api/docs/reference/v201806/CampaignExtensionSettingService#query
 func (s *CampaignExtensionSettingService) Query(query string) (campaignExtensionSettings []CampaignExtensionSetting, totalCount int64, err error) {
-
 	respBody, err := s.Auth.request(
-		adGroupServiceUrl,
+		campaignExtensionSettingServiceUrl,
 		"query",
 		AWQLQuery{
 			XMLName: xml.Name{
-				Space: baseUrl,
+				Space: s.Auth.namespace(campaignExtensionSettingServiceUrl),
 				Local: "query",
 			},
 			Query: query,
 		},
 	)
-
 	if err != nil {
 		return campaignExtensionSettings, totalCount, err
 	}
-	getResp := struct {
+	queryResp := struct {
 		Size                      int64                      `xml:"rval>totalNumEntries"`
 		CampaignExtensionSettings []CampaignExtensionSetting `xml:"rval>entries"`
 	}{}
-	fmt.Printf("%s\n", respBody)
-	err = xml.Unmarshal([]byte(respBody), &getResp)
+	err = xml.Unmarshal([]byte(respBody), &queryResp)
 	if err != nil {
 		return campaignExtensionSettings, totalCount, err
 	}
-	return getResp.CampaignExtensionSettings, getResp.Size, err
-
+	return queryResp.CampaignExtensionSettings, queryResp.Size, err
 }
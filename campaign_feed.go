@@ -1,5 +1,9 @@
 package gads
 
+import (
+	"encoding/xml"
+)
+
 type CampaignFeedService struct {
 	Auth
 }
@@ -7,3 +11,194 @@ type CampaignFeedService struct {
 func NewCampaignFeedService(auth *Auth) *CampaignFeedService {
 	return &CampaignFeedService{Auth: *auth}
 }
+
+// CampaignFeed links a feed to a campaign, the matching function selects
+// the feed items served with its ads.
+//
+// PlaceholderTypes: see the PlaceholderType constants
+// Status: ENABLED, REMOVED
+type CampaignFeed struct {
+	FeedId           int64     `xml:"feedId"`
+	CampaignId       int64     `xml:"campaignId"`
+	MatchingFunction *Function `xml:"matchingFunction,omitempty"`
+	PlaceholderTypes []int64   `xml:"placeholderTypes,omitempty"`
+	Status           string    `xml:"status,omitempty"`
+	BaseCampaignId   int64     `xml:"baseCampaignId,omitempty"`
+}
+
+// CampaignFeedOperations is a map of operations to perform on
+// CampaignFeed's, campaign feeds can only be added and removed.
+type CampaignFeedOperations map[string][]CampaignFeed
+
+// CampaignFeedFields lists the fields of the campaign feeds the
+// CampaignFeedService selects and filters on, see FieldCatalog.
+var CampaignFeedFields = FieldCatalog{
+	Service: "CampaignFeedService",
+	Selectable: []string{
+		"FeedId", "CampaignId", "MatchingFunction", "PlaceholderTypes", "Status", "BaseCampaignId",
+	},
+	Filterable: []string{
+		"FeedId", "CampaignId", "PlaceholderTypes", "Status", "BaseCampaignId",
+	},
+}
+
+// Get returns an array of CampaignFeed's and the total number of
+// CampaignFeed's matching the selector.
+//
+// Example
+//
+//   campaignFeeds, totalCount, err := campaignFeedService.Get(
+//     gads.Selector{
+//       Fields: []string{"FeedId", "CampaignId", "MatchingFunction", "PlaceholderTypes"},
+//       Predicates: []gads.Predicate{
+//         {"Status", "EQUALS", []string{"ENABLED"}},
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/CampaignFeedService#get
+//
+func (s *CampaignFeedService) Get(selector Selector) (campaignFeeds []CampaignFeed, totalCount int64, err error) {
+	if err := CampaignFeedFields.Validate(selector); err != nil {
+		return campaignFeeds, totalCount, err
+	}
+	selector.XMLName = xml.Name{"", "selector"}
+	respBody, err := s.Auth.request(
+		campaignFeedServiceUrl,
+		"get",
+		struct {
+			XMLName xml.Name
+			Sel     Selector
+		}{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(campaignFeedServiceUrl),
+				Local: "get",
+			},
+			Sel: selector,
+		},
+	)
+	if err != nil {
+		return campaignFeeds, totalCount, err
+	}
+	getResp := struct {
+		Size          int64          `xml:"rval>totalNumEntries"`
+		CampaignFeeds []CampaignFeed `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return campaignFeeds, totalCount, err
+	}
+	return getResp.CampaignFeeds, getResp.Size, err
+}
+
+// All returns a Pager over all the campaign feeds matching selector.
+func (s *CampaignFeedService) All(selector Selector) *Pager[CampaignFeed] {
+	return NewPager(s.Get, selector)
+}
+
+// Mutate allows you to link feeds to campaigns and unlink them, returning
+// the modified campaign feeds.
+//
+// Example
+//
+//   campaignFeeds, err := campaignFeedService.Mutate(
+//     gads.CampaignFeedOperations{
+//       "ADD": {
+//         gads.CampaignFeed{
+//           FeedId:           feed.Id,
+//           CampaignId:       campaign.Id,
+//           PlaceholderTypes: []int64{gads.PlaceholderTypeSitelink},
+//           MatchingFunction: &gads.Function{
+//             Operator:   "IN",
+//             LhsOperand: gads.FunctionArgumentOperands{gads.RequestContextOperand{ContextType: "FEED_ITEM_ID"}},
+//             RhsOperand: gads.FunctionArgumentOperands{gads.NewLongConstant(feedItem.FeedItemID)},
+//           },
+//         },
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/CampaignFeedService#mutate
+//
+func (s *CampaignFeedService) Mutate(campaignFeedOperations CampaignFeedOperations) (campaignFeeds []CampaignFeed, err error) {
+	type campaignFeedOperation struct {
+		Action       string       `xml:"operator"`
+		CampaignFeed CampaignFeed `xml:"operand"`
+	}
+	operations := []campaignFeedOperation{}
+	for action, campaignFeeds := range campaignFeedOperations {
+		for _, campaignFeed := range campaignFeeds {
+			operations = append(operations,
+				campaignFeedOperation{
+					Action:       action,
+					CampaignFeed: campaignFeed,
+				},
+			)
+		}
+	}
+	mutation := struct {
+		XMLName xml.Name
+		Ops     []campaignFeedOperation `xml:"operations"`
+	}{
+		XMLName: xml.Name{
+			Space: s.Auth.namespace(campaignFeedServiceUrl),
+			Local: "mutate",
+		},
+		Ops: operations,
+	}
+	respBody, err := s.Auth.request(campaignFeedServiceUrl, "mutate", mutation)
+	if err != nil {
+		return campaignFeeds, err
+	}
+	mutateResp := struct {
+		BaseResponse
+		CampaignFeeds []CampaignFeed `xml:"rval>value"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &mutateResp)
+	if err != nil {
+		return campaignFeeds, err
+	}
+
+	if len(mutateResp.PartialFailureErrors) > 0 {
+		err = mutateResp.PartialFailureErrors
+	}
+
+	return mutateResp.CampaignFeeds, err
+}
+
+// Query returns the campaign feeds matching the awql query, and their
+// total count regardless of the LIMIT clause.
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/CampaignFeedService#query
+//
+func (s *CampaignFeedService) Query(query string) (campaignFeeds []CampaignFeed, totalCount int64, err error) {
+	respBody, err := s.Auth.request(
+		campaignFeedServiceUrl,
+		"query",
+		AWQLQuery{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(campaignFeedServiceUrl),
+				Local: "query",
+			},
+			Query: query,
+		},
+	)
+	if err != nil {
+		return campaignFeeds, totalCount, err
+	}
+	queryResp := struct {
+		Size          int64          `xml:"rval>totalNumEntries"`
+		CampaignFeeds []CampaignFeed `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &queryResp)
+	if err != nil {
+		return campaignFeeds, totalCount, err
+	}
+	return queryResp.CampaignFeeds, queryResp.Size, err
+}
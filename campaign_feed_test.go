@@ -0,0 +1,97 @@
+package gads
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var testOperand = regexp.MustCompile(`(?s)<operand>(.*?)</operand>`)
+
+// testEchoOperands answers a mutate request with its operands, as the api
+// does once they are applied.
+func testEchoOperands(request testRequest) string {
+	operands := strings.Join(testOperand.FindAllString(request.Body, -1), "")
+	return testOperand.ReplaceAllString(operands, "<value>$1</value>")
+}
+
+func TestCampaignFeedMatchingFunction(t *testing.T) {
+	var requests []testRequest
+	auth, cleanup := testServiceAuth(t, &requests, testEchoOperands)
+	defer cleanup()
+
+	// IN(FEED_ITEM_ID, {10, 11}) AND EQUALS(CONTEXT.DEVICE, "Mobile")
+	matchingFunction := &Function{
+		Operator: "AND",
+		LhsOperand: FunctionArgumentOperands{
+			FunctionOperand{Value: Function{
+				Operator:   "IN",
+				LhsOperand: FunctionArgumentOperands{RequestContextOperand{ContextType: "FEED_ITEM_ID"}},
+				RhsOperand: FunctionArgumentOperands{NewLongConstant(10), NewLongConstant(11)},
+			}},
+			FunctionOperand{Value: Function{
+				Operator:   "EQUALS",
+				LhsOperand: FunctionArgumentOperands{FeedAttributeOperand{FeedId: 31, FeedAttributeId: 3}},
+				RhsOperand: FunctionArgumentOperands{NewStringConstant("Mobile")},
+			}},
+		},
+	}
+	campaignFeeds, err := NewCampaignFeedService(&auth).Mutate(CampaignFeedOperations{
+		"ADD": {{FeedId: 31, CampaignId: 41, PlaceholderTypes: []int64{PlaceholderTypeSitelink}, MatchingFunction: matchingFunction}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		`<lhsOperand xsi:type="FunctionOperand">`,
+		`<lhsOperand xsi:type="RequestContextOperand">`,
+		`<rhsOperand xsi:type="ConstantOperand">`,
+		`<lhsOperand xsi:type="FeedAttributeOperand">`,
+		`<longValue>11</longValue>`,
+		`<placeholderTypes>1</placeholderTypes>`,
+	} {
+		if !strings.Contains(requests[0].Body, expected) {
+			t.Errorf("expected %s in the request\n%s", expected, requests[0].Body)
+		}
+	}
+
+	// the function parsed back is the one sent, with the xsi types set
+	if len(campaignFeeds) != 1 || campaignFeeds[0].CampaignId != 41 || campaignFeeds[0].MatchingFunction == nil {
+		t.Fatalf("unexpected campaign feeds %#v", campaignFeeds)
+	}
+	expected := addXSIType(*matchingFunction).(Function)
+	expected.RhsOperand = nil // made empty by addXSIType
+	if !reflect.DeepEqual(*campaignFeeds[0].MatchingFunction, expected) {
+		t.Errorf("expected the matching function %#v, got %#v", expected, *campaignFeeds[0].MatchingFunction)
+	}
+
+	adGroupFeeds, err := NewAdGroupFeedService(&auth).Mutate(AdGroupFeedOperations{
+		"ADD": {{
+			FeedId:           31,
+			AdGroupId:        51,
+			PlaceholderTypes: []int64{PlaceholderTypeCallout},
+			MatchingFunction: &Function{Operator: "IDENTITY", LhsOperand: FunctionArgumentOperands{NewBooleanConstant(true)}},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(adGroupFeeds) != 1 || adGroupFeeds[0].AdGroupId != 51 ||
+		*adGroupFeeds[0].MatchingFunction.LhsOperand[0].(ConstantOperand).BooleanValue != true {
+		t.Errorf("unexpected ad group feeds %#v", adGroupFeeds)
+	}
+
+	customerFeeds, err := NewCustomerFeedService(&auth).Mutate(CustomerFeedOperations{
+		"REMOVE": {{FeedId: 31, PlaceholderTypes: []int64{PlaceholderTypeLocation}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(customerFeeds) != 1 || customerFeeds[0].MatchingFunction != nil || customerFeeds[0].PlaceholderTypes[0] != PlaceholderTypeLocation {
+		t.Errorf("unexpected customer feeds %#v", customerFeeds)
+	}
+	if strings.Contains(requests[2].Body, "matchingFunction") {
+		t.Errorf("unexpected matching function in the request\n%s", requests[2].Body)
+	}
+}
@@ -1,5 +1,9 @@
 package gads
 
+import (
+	"encoding/xml"
+)
+
 type CampaignSharedSetService struct {
 	Auth
 }
@@ -7,3 +11,153 @@ type CampaignSharedSetService struct {
 func NewCampaignSharedSetService(auth *Auth) *CampaignSharedSetService {
 	return &CampaignSharedSetService{Auth: *auth}
 }
+
+// CampaignSharedSet attaches a SharedSet to a campaign, only the ids are
+// needed to add or remove one.
+//
+// SharedSetType: NEGATIVE_KEYWORDS, NEGATIVE_PLACEMENTS
+// Status: ENABLED, REMOVED
+type CampaignSharedSet struct {
+	SharedSetId   int64  `xml:"sharedSetId"`
+	CampaignId    int64  `xml:"campaignId"`
+	SharedSetName string `xml:"sharedSetName,omitempty"`
+	SharedSetType string `xml:"sharedSetType,omitempty"`
+	CampaignName  string `xml:"campaignName,omitempty"`
+	Status        string `xml:"status,omitempty"`
+}
+
+// CampaignSharedSetOperations is a map of operations to perform on
+// CampaignSharedSet's, they can only be added and removed.
+type CampaignSharedSetOperations map[string][]CampaignSharedSet
+
+// CampaignSharedSetFields lists the fields of the campaign shared sets the
+// CampaignSharedSetService selects and filters on, see FieldCatalog.
+var CampaignSharedSetFields = FieldCatalog{
+	Service: "CampaignSharedSetService",
+	Selectable: []string{
+		"SharedSetId", "CampaignId", "SharedSetName", "SharedSetType", "CampaignName", "Status",
+	},
+	Filterable: []string{
+		"SharedSetId", "CampaignId", "SharedSetName", "SharedSetType", "CampaignName", "Status",
+	},
+}
+
+// Get returns an array of CampaignSharedSet's and the total number of
+// CampaignSharedSet's matching the selector.
+//
+// Example
+//
+//   campaignSharedSets, totalCount, err := campaignSharedSetService.Get(
+//     gads.Selector{
+//       Fields: []string{"SharedSetId", "CampaignId", "SharedSetName", "Status"},
+//       Predicates: []gads.Predicate{
+//         {"CampaignId", "IN", campaignIds},
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/CampaignSharedSetService#get
+//
+func (s *CampaignSharedSetService) Get(selector Selector) (campaignSharedSets []CampaignSharedSet, totalCount int64, err error) {
+	if err := CampaignSharedSetFields.Validate(selector); err != nil {
+		return campaignSharedSets, totalCount, err
+	}
+	selector.XMLName = xml.Name{"", "selector"}
+	respBody, err := s.Auth.request(
+		campaignSharedSetServiceUrl,
+		"get",
+		struct {
+			XMLName xml.Name
+			Sel     Selector
+		}{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(campaignSharedSetServiceUrl),
+				Local: "get",
+			},
+			Sel: selector,
+		},
+	)
+	if err != nil {
+		return campaignSharedSets, totalCount, err
+	}
+	getResp := struct {
+		Size               int64               `xml:"rval>totalNumEntries"`
+		CampaignSharedSets []CampaignSharedSet `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return campaignSharedSets, totalCount, err
+	}
+	return getResp.CampaignSharedSets, getResp.Size, err
+}
+
+// All returns a Pager over all the campaign shared sets matching selector.
+func (s *CampaignSharedSetService) All(selector Selector) *Pager[CampaignSharedSet] {
+	return NewPager(s.Get, selector)
+}
+
+// Mutate allows you to attach shared sets to campaigns and detach them,
+// returning the modified campaign shared sets.
+//
+// Example
+//
+//   operations := gads.CampaignSharedSetOperations{}
+//   for _, campaign := range campaigns {
+//     operations["ADD"] = append(operations["ADD"], gads.CampaignSharedSet{
+//       SharedSetId: sharedSet.Id,
+//       CampaignId:  campaign.Id,
+//     })
+//   }
+//   campaignSharedSets, err := campaignSharedSetService.Mutate(operations)
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/CampaignSharedSetService#mutate
+//
+func (s *CampaignSharedSetService) Mutate(campaignSharedSetOperations CampaignSharedSetOperations) (campaignSharedSets []CampaignSharedSet, err error) {
+	type campaignSharedSetOperation struct {
+		Action            string            `xml:"operator"`
+		CampaignSharedSet CampaignSharedSet `xml:"operand"`
+	}
+	operations := []campaignSharedSetOperation{}
+	for action, campaignSharedSets := range campaignSharedSetOperations {
+		for _, campaignSharedSet := range campaignSharedSets {
+			operations = append(operations,
+				campaignSharedSetOperation{
+					Action:            action,
+					CampaignSharedSet: campaignSharedSet,
+				},
+			)
+		}
+	}
+	mutation := struct {
+		XMLName xml.Name
+		Ops     []campaignSharedSetOperation `xml:"operations"`
+	}{
+		XMLName: xml.Name{
+			Space: s.Auth.namespace(campaignSharedSetServiceUrl),
+			Local: "mutate",
+		},
+		Ops: operations,
+	}
+	respBody, err := s.Auth.request(campaignSharedSetServiceUrl, "mutate", mutation)
+	if err != nil {
+		return campaignSharedSets, err
+	}
+	mutateResp := struct {
+		BaseResponse
+		CampaignSharedSets []CampaignSharedSet `xml:"rval>value"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &mutateResp)
+	if err != nil {
+		return campaignSharedSets, err
+	}
+
+	if len(mutateResp.PartialFailureErrors) > 0 {
+		err = mutateResp.PartialFailureErrors
+	}
+
+	return mutateResp.CampaignSharedSets, err
+}
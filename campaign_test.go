@@ -2,6 +2,9 @@ package gads
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 )
@@ -154,3 +157,24 @@ func TestCampaign(t *testing.T) {
 	}
 
 }
+
+func TestCampaignQuery(t *testing.T) {
+	var action, body string
+	auth, cleanup := testServerAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		action, body = r.Header.Get("SOAPAction"), string(b)
+		fmt.Fprint(w, strings.Replace(testCampaignGetResponse, "getResponse", "queryResponse", -1))
+	})
+	defer cleanup()
+
+	campaigns, totalCount, err := NewCampaignService(&auth).Query("SELECT Id, Name WHERE Name = 'test campaign'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if action != "query" || !strings.Contains(body, "<query>SELECT Id, Name WHERE Name = &#39;test campaign&#39;</query>") {
+		t.Errorf("unexpected request %s\n%s", action, body)
+	}
+	if totalCount != 1 || len(campaigns) != 1 || campaigns[0].Id != 1234 {
+		t.Errorf("unexpected campaigns %#v", campaigns)
+	}
+}
@@ -0,0 +1,273 @@
+package gads
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"unicode/utf8"
+)
+
+// Cassette holds the http traffic of an Auth, soap requests and report
+// downloads, recorded to a file to be replayed later without credentials
+// nor network access.
+//
+// A recording cassette sends the requests and writes each request and its
+// response to the file, the oauth bearer and the developer token being
+// redacted. A replaying cassette answers the requests with the recorded
+// responses, in the order they were recorded, and fails the requests that
+// don't match the method, url and soap action of the recorded ones.
+//
+// Example
+//
+//   cassette, err := gads.RecordCassette("testdata/campaigns.jsonl")
+//   defer cassette.Close()
+//   auth.Cassette = cassette
+//
+//   // later on
+//   cassette, err := gads.LoadCassette("testdata/campaigns.jsonl")
+//   auth := gads.Auth{Cassette: cassette}
+//
+type Cassette struct {
+	path      string
+	replaying bool
+
+	mu           sync.Mutex
+	file         *os.File // recording only
+	interactions []Interaction
+	next         int // index of the next interaction to replay
+}
+
+// Interaction is a request and its response, as stored in a cassette file,
+// one json object per line.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request stored in a cassette
+type RecordedRequest struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body,omitempty"`
+	BinaryBody []byte      `json:"binaryBody,omitempty"` // used when the body is not utf-8
+}
+
+// RecordedResponse is a response stored in a cassette
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body,omitempty"`
+	BinaryBody []byte      `json:"binaryBody,omitempty"` // used when the body is not utf-8
+}
+
+// RecordCassette returns a Cassette recording to the file at path, replacing
+// it if it exists. The cassette must be closed once the recording is over.
+func RecordCassette(path string) (*Cassette, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Cassette{path: path, file: file}, nil
+}
+
+// LoadCassette returns a Cassette replaying the interactions recorded in
+// the file at path.
+func LoadCassette(path string) (*Cassette, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	c := &Cassette{path: path, replaying: true}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 64*1024*1024)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		interaction := Interaction{}
+		if err := json.Unmarshal(scanner.Bytes(), &interaction); err != nil {
+			return nil, fmt.Errorf("cassette %s: %s", path, err)
+		}
+		c.interactions = append(c.interactions, interaction)
+	}
+	return c, scanner.Err()
+}
+
+// Interactions returns the interactions recorded so far, or loaded from the
+// file when replaying.
+func (c *Cassette) Interactions() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Interaction(nil), c.interactions...)
+}
+
+// Close closes the file of a recording cassette.
+func (c *Cassette) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.file == nil {
+		return nil
+	}
+	err := c.file.Close()
+	c.file = nil
+	return err
+}
+
+// client returns the http client to send the requests of the Auth with
+func (a *Auth) client() *http.Client {
+	if a.Cassette == nil {
+		return a.Client
+	}
+	client := http.Client{}
+	if a.Client != nil {
+		client = *a.Client
+	}
+	if a.Cassette.replaying {
+		// the replayed responses are immediate, the default timeout only
+		// lets the reports be requested without a configured client
+		if client.Timeout == 0 {
+			client.Timeout = reportTimeout
+		}
+		client.Transport = cassetteTransport{cassette: a.Cassette}
+		return &client
+	}
+	base := client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	client.Transport = cassetteTransport{cassette: a.Cassette, base: base}
+	return &client
+}
+
+// cassetteTransport records the traffic sent to base or replays it when
+// base is nil.
+type cassetteTransport struct {
+	cassette *Cassette
+	base     http.RoundTripper
+}
+
+func (t cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody := []byte{}
+	if req.Body != nil {
+		var err error
+		if reqBody, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = ioutil.NopCloser(bytes.NewReader(reqBody))
+	}
+	if t.base == nil {
+		return t.cassette.replay(req)
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: redactedHeader(req.Header),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header,
+		},
+	}
+	interaction.Request.Body, interaction.Request.BinaryBody = recordedBody(redactedBody(reqBody))
+	interaction.Response.Body, interaction.Response.BinaryBody = recordedBody(respBody)
+	return resp, t.cassette.record(interaction)
+}
+
+// recordedBody returns the body as text if possible, as binary otherwise
+func recordedBody(body []byte) (string, []byte) {
+	if utf8.Valid(body) {
+		return string(body), nil
+	}
+	return "", body
+}
+
+// record appends the interaction to the cassette file
+func (c *Cassette) record(interaction Interaction) error {
+	line, err := json.Marshal(interaction)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.file == nil {
+		return fmt.Errorf("cassette %s is closed", c.path)
+	}
+	c.interactions = append(c.interactions, interaction)
+	_, err = c.file.Write(append(line, '\n'))
+	return err
+}
+
+// CassetteError is a request a replaying cassette has no recorded response
+// for. It is never retried, replaying it again would fail the same way.
+type CassetteError struct {
+	Path   string
+	Reason string
+}
+
+func (e CassetteError) Error() string {
+	return fmt.Sprintf("cassette %s: %s", e.Path, e.Reason)
+}
+
+// replay returns the next recorded response, if it was recorded for a
+// request similar to req
+func (c *Cassette) replay(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.next >= len(c.interactions) {
+		return nil, CassetteError{Path: c.path, Reason: fmt.Sprintf("no interaction left to replay %s %s", req.Method, req.URL)}
+	}
+	interaction := c.interactions[c.next]
+	recorded := interaction.Request
+	if recorded.Method != req.Method || recorded.URL != req.URL.String() ||
+		recorded.Header.Get("SOAPAction") != req.Header.Get("SOAPAction") {
+		return nil, CassetteError{Path: c.path, Reason: fmt.Sprintf(
+			"interaction %d was recorded for %s %s %s, not %s %s %s",
+			c.next,
+			recorded.Method, recorded.URL, recorded.Header.Get("SOAPAction"),
+			req.Method, req.URL, req.Header.Get("SOAPAction"),
+		)}
+	}
+	c.next++
+
+	body := interaction.Response.BinaryBody
+	if body == nil {
+		body = []byte(interaction.Response.Body)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        interaction.Response.Header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,122 @@
+package gads
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCassette(t *testing.T) {
+	auth, cleanup := testServerAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		ioutil.ReadAll(r.Body)
+		fmt.Fprint(w, testCampaignGetResponse)
+	})
+	path := filepath.Join(t.TempDir(), "cassettes", "campaigns.jsonl")
+	cassette, err := RecordCassette(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth.Cassette = cassette
+	selector := Selector{Fields: []string{"Id", "Name"}}
+	recorded, totalCount, err := NewCampaignService(&auth).Get(selector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cleanup()
+	if err := cassette.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(file), auth.DeveloperToken) {
+		t.Errorf("the developer token must be redacted from the cassette\n%s", file)
+	}
+
+	cassette, err = LoadCassette(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cassette.Interactions()) != 1 {
+		t.Fatalf("expected 1 interaction, got %d", len(cassette.Interactions()))
+	}
+	auth.Cassette = cassette
+	cs := NewCampaignService(&auth)
+	replayed, replayedCount, err := cs.Get(selector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayedCount != totalCount || fmt.Sprint(replayed) != fmt.Sprint(recorded) {
+		t.Errorf("expected %v, got %v", recorded, replayed)
+	}
+
+	if _, _, err := cs.Get(selector); err == nil || !strings.Contains(err.Error(), "no interaction left") {
+		t.Errorf("expected the cassette to be exhausted, got %v", err)
+	}
+}
+
+func TestCassetteMismatch(t *testing.T) {
+	cassette := &Cassette{
+		path:      "mismatch.jsonl",
+		replaying: true,
+		interactions: []Interaction{{
+			Request:  RecordedRequest{Method: "POST", URL: DefaultEndpoint + "/cm/" + apiVersion + "/AdGroupService"},
+			Response: RecordedResponse{StatusCode: 200, Body: testCampaignGetResponse},
+		}},
+	}
+	auth := Auth{CustomerId: "123-456-7890", Cassette: cassette}
+	_, _, err := NewCampaignService(&auth).Get(Selector{Fields: []string{"Id"}})
+	if err == nil || !strings.Contains(err.Error(), "was recorded for POST") {
+		t.Errorf("expected a mismatch, got %v", err)
+	}
+}
+
+func TestCassetteReplayReport(t *testing.T) {
+	auth := Auth{CustomerId: "123-456-7890"}
+	auth.Cassette = &Cassette{
+		path:      "report.jsonl",
+		replaying: true,
+		interactions: []Interaction{{
+			Request:  RecordedRequest{Method: "POST", URL: auth.reportURL()},
+			Response: RecordedResponse{StatusCode: 200, Body: "Campaign ID,Clicks\n1,10\n"},
+		}},
+	}
+	body, err := NewReportDefinitionService(&auth).Request(&ReportDefinition{
+		ReportName:     "clicks",
+		ReportType:     "CAMPAIGN_PERFORMANCE_REPORT",
+		DownloadFormat: DownloadFormatCSV,
+		Selector:       Selector{Fields: []string{"CampaignId", "Clicks"}},
+	})
+	if err != nil {
+		t.Fatalf("expected the report to be replayed without a client, got %v", err)
+	}
+	defer body.Close()
+	if report, _ := ioutil.ReadAll(body); string(report) != "Campaign ID,Clicks\n1,10\n" {
+		t.Errorf("unexpected report %q", report)
+	}
+}
+
+func TestCassetteMismatchNotRetried(t *testing.T) {
+	auth := Auth{
+		CustomerId:  "123-456-7890",
+		Cassette:    &Cassette{path: "empty.jsonl", replaying: true},
+		RetryPolicy: &RetryPolicy{MaxRetries: 3, BaseDelay: time.Hour},
+	}
+	start := time.Now()
+	_, _, err := NewCampaignService(&auth).Get(Selector{Fields: []string{"Id"}})
+	if urlErr, ok := err.(*url.Error); !ok {
+		t.Fatalf("expected the cassette error in a url error, got %#v", err)
+	} else if _, ok := urlErr.Err.(CassetteError); !ok {
+		t.Errorf("expected a CassetteError, got %#v", urlErr.Err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected the mismatch not to be retried, waited %s", elapsed)
+	}
+}
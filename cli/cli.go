@@ -10,6 +10,8 @@ import (
 	"github.com/querian/gads"
 )
 
+const pageSize = 500
+
 func main() {
 	config, err := gads.NewCredentials(context.Background())
 	if err != nil {
@@ -17,167 +19,118 @@ func main() {
 	}
 	bs := gads.NewBudgetService(&config.Auth)
 
-	var pageSize int64 = 500
-	var offset int64 = 0
-	paging := gads.Paging{
-		Offset: offset,
-		Limit:  pageSize,
-	}
 	fmt.Printf("\nBudgets\n")
-	for {
-		foundBudgets, totalCount, err := bs.Get(gads.Selector{
-			Fields: []string{
-				"BudgetId",
-				"BudgetName",
-				"Amount",
-				"DeliveryMethod",
-				"BudgetReferenceCount",
-				"IsBudgetExplicitlyShared",
-				"BudgetStatus",
-			},
-			Paging: &paging,
-		})
-		if err != nil {
-			log.Fatal(err)
-		}
-		for _, budget := range foundBudgets {
-			budgetJson, _ := json.MarshalIndent(budget, "", "  ")
-			fmt.Printf("  %s\n", string(budgetJson))
-		}
-		offset += pageSize
-		paging.Offset = offset
-		if totalCount < offset {
-			break
-		}
+	budgets := bs.All(gads.Selector{
+		Fields: []string{
+			"BudgetId",
+			"BudgetName",
+			"Amount",
+			"DeliveryMethod",
+			"BudgetReferenceCount",
+			"IsBudgetExplicitlyShared",
+			"BudgetStatus",
+		},
+	})
+	budgets.PageSize = pageSize
+	for budgets.Next() {
+		budgetJson, _ := json.MarshalIndent(budgets.Value(), "", "  ")
+		fmt.Printf("  %s\n", string(budgetJson))
+	}
+	if err := budgets.Err(); err != nil {
+		log.Fatal(err)
 	}
 
 	// show all Campaigns
 	cs := gads.NewCampaignService(&config.Auth)
-	offset = 0
-	paging = gads.Paging{
-		Offset: offset,
-		Limit:  pageSize,
-	}
 	fmt.Printf("\nCampaigns\n")
-	for {
-		foundCampaigns, totalCount, err := cs.Get(
-			gads.Selector{
-				Fields: []string{
-					"Id",
-					"BudgetId",
-					"Name",
-					"Status",
-					"ServingStatus",
-					"StartDate",
-					"EndDate",
-					"AdServingOptimizationStatus",
-					"Settings",
-					"AdvertisingChannelType",
-					"AdvertisingChannelSubType",
-					"Labels",
-					"TrackingUrlTemplate",
-					"UrlCustomParameters",
-				},
-				Predicates: []gads.Predicate{
-					{"Status", "EQUALS", []string{"PAUSED"}},
-				},
-				Ordering: []gads.OrderBy{
-					{"Id", "ASCENDING"},
-				},
-				Paging: &paging,
+	campaigns := cs.All(
+		gads.Selector{
+			Fields: []string{
+				"Id",
+				"BudgetId",
+				"Name",
+				"Status",
+				"ServingStatus",
+				"StartDate",
+				"EndDate",
+				"AdServingOptimizationStatus",
+				"Settings",
+				"AdvertisingChannelType",
+				"AdvertisingChannelSubType",
+				"Labels",
+				"TrackingUrlTemplate",
+				"UrlCustomParameters",
 			},
-		)
-		if err != nil {
-			log.Fatal(err)
-		}
-		for _, campaign := range foundCampaigns {
-			campaignJson, _ := json.MarshalIndent(campaign, "", "  ")
-			fmt.Printf("%s\n", campaignJson)
-		}
-		offset += pageSize
-		paging.Offset = offset
-		if totalCount < offset {
-			break
-		}
+			Predicates: []gads.Predicate{
+				{"Status", "EQUALS", []string{"PAUSED"}},
+			},
+			Ordering: []gads.OrderBy{
+				{"Id", "ASCENDING"},
+			},
+		},
+	)
+	campaigns.PageSize = pageSize
+	for campaigns.Next() {
+		campaignJson, _ := json.MarshalIndent(campaigns.Value(), "", "  ")
+		fmt.Printf("%s\n", campaignJson)
+	}
+	if err := campaigns.Err(); err != nil {
+		log.Fatal(err)
 	}
 
 	ags := gads.NewAdGroupService(&config.Auth)
-	offset = 0
-	paging = gads.Paging{
-		Offset: offset,
-		Limit:  pageSize,
-	}
 	fmt.Printf("\nAdGroups\n")
-	for {
-		foundAdGroups, totalCount, err := ags.Get(
-			gads.Selector{
-				Fields: []string{
-					"Id",
-					"CampaignId",
-					"CampaignName",
-					"Name",
-					"Status",
-					"Settings",
-					"ContentBidCriterionTypeGroup",
-				},
-				Predicates: []gads.Predicate{
-					{"Status", "EQUALS", []string{"PAUSED"}},
-				},
-				Ordering: []gads.OrderBy{
-					{"Id", "ASCENDING"},
-				},
-				Paging: &paging,
+	adGroups := ags.All(
+		gads.Selector{
+			Fields: []string{
+				"Id",
+				"CampaignId",
+				"CampaignName",
+				"Name",
+				"Status",
+				"Settings",
+				"ContentBidCriterionTypeGroup",
 			},
-		)
-		if err != nil {
-			log.Fatal(err)
-		}
-		for _, adGroup := range foundAdGroups {
-			adGroupJson, _ := json.MarshalIndent(adGroup, "", "  ")
-			fmt.Printf("%#v\n", adGroupJson)
-		}
-		offset += pageSize
-		paging.Offset = offset
-		if totalCount < offset {
-			break
-		}
+			Predicates: []gads.Predicate{
+				{"Status", "EQUALS", []string{"PAUSED"}},
+			},
+			Ordering: []gads.OrderBy{
+				{"Id", "ASCENDING"},
+			},
+		},
+	)
+	adGroups.PageSize = pageSize
+	for adGroups.Next() {
+		adGroupJson, _ := json.MarshalIndent(adGroups.Value(), "", "  ")
+		fmt.Printf("%s\n", adGroupJson)
+	}
+	if err := adGroups.Err(); err != nil {
+		log.Fatal(err)
 	}
 
 	agas := gads.NewAdGroupAdService(&config.Auth)
-	offset = 0
-	paging = gads.Paging{
-		Offset: offset,
-		Limit:  pageSize,
-	}
 	fmt.Printf("\nAds\n")
-	for {
-		foundAds, totalCount, err := agas.Get(
-			gads.Selector{
-				Fields: []string{
-					"AdGroupId",
-					"Status",
-					"AdGroupCreativeApprovalStatus",
-					"AdGroupAdDisapprovalReasons",
-					"AdGroupAdTrademarkDisapproved",
-				},
-				Ordering: []gads.OrderBy{
-					{"AdGroupId", "ASCENDING"},
-					{"Id", "ASCENDING"},
-				},
-				Paging: &paging,
+	ads := agas.All(
+		gads.Selector{
+			Fields: []string{
+				"AdGroupId",
+				"Status",
+				"AdGroupCreativeApprovalStatus",
+				"AdGroupAdDisapprovalReasons",
+				"AdGroupAdTrademarkDisapproved",
 			},
-		)
-		if err != nil {
-			log.Fatal(err)
-		}
-		for _, ad := range foundAds {
-			adJson, _ := json.MarshalIndent(ad, "", "  ")
-			fmt.Printf("%s\n", adJson)
-		}
-		offset += pageSize
-		paging.Offset = offset
-		if totalCount < offset {
-			break
-		}
+			Ordering: []gads.OrderBy{
+				{"AdGroupId", "ASCENDING"},
+				{"Id", "ASCENDING"},
+			},
+		},
+	)
+	ads.PageSize = pageSize
+	for ads.Next() {
+		adJson, _ := json.MarshalIndent(ads.Value(), "", "  ")
+		fmt.Printf("%s\n", adJson)
+	}
+	if err := ads.Err(); err != nil {
+		log.Fatal(err)
 	}
 }
@@ -40,7 +40,7 @@ func (s *ConstantDataService) GetProductBiddingCategoryDatas(selector Selector)
 			Sel     Selector
 		}{
 			XMLName: xml.Name{
-				Space: baseUrl,
+				Space: s.Auth.namespace(constantDataServiceUrl),
 				Local: "getProductBiddingCategoryData",
 			},
 			Sel: selector,
@@ -65,8 +65,10 @@ func (s *ConstantDataService) GetAgeRangeCriterion() (ageRanges []AgeRangeCriter
 		constantDataServiceUrl,
 		"getAgeRangeCriterion",
 		struct {
-			XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201806 getAgeRangeCriterion"`
-		}{},
+			XMLName xml.Name
+		}{
+			XMLName: xml.Name{Space: s.Auth.namespace(constantDataServiceUrl), Local: "getAgeRangeCriterion"},
+		},
 	)
 	if err != nil {
 		return ageRanges, err
@@ -86,8 +88,10 @@ func (s *ConstantDataService) GetCarrierCriterion() (carriers []CarrierCriterion
 		constantDataServiceUrl,
 		"getCarrierCriterion",
 		struct {
-			XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201806 getCarrierCriterion"`
-		}{},
+			XMLName xml.Name
+		}{
+			XMLName: xml.Name{Space: s.Auth.namespace(constantDataServiceUrl), Local: "getCarrierCriterion"},
+		},
 	)
 	if err != nil {
 		return carriers, err
@@ -107,8 +111,10 @@ func (s *ConstantDataService) GetGenderCriterion() (genders []GenderCriterion, e
 		constantDataServiceUrl,
 		"getGenderCriterion",
 		struct {
-			XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201806 getGenderCriterion"`
-		}{},
+			XMLName xml.Name
+		}{
+			XMLName: xml.Name{Space: s.Auth.namespace(constantDataServiceUrl), Local: "getGenderCriterion"},
+		},
 	)
 	if err != nil {
 		return genders, err
@@ -128,8 +134,10 @@ func (s *ConstantDataService) GetLanguageCriterion() (languages []LanguageCriter
 		constantDataServiceUrl,
 		"getLanguageCriterion",
 		struct {
-			XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201806 getLanguageCriterion"`
-		}{},
+			XMLName xml.Name
+		}{
+			XMLName: xml.Name{Space: s.Auth.namespace(constantDataServiceUrl), Local: "getLanguageCriterion"},
+		},
 	)
 	if err != nil {
 		return languages, err
@@ -149,8 +157,10 @@ func (s *ConstantDataService) GetMobileDeviceCriterion() (mobileDevices []Mobile
 		constantDataServiceUrl,
 		"getMobileDeviceCriterion",
 		struct {
-			XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201806 getMobileDeviceCriterion"`
-		}{},
+			XMLName xml.Name
+		}{
+			XMLName: xml.Name{Space: s.Auth.namespace(constantDataServiceUrl), Local: "getMobileDeviceCriterion"},
+		},
 	)
 	if err != nil {
 		return mobileDevices, err
@@ -170,8 +180,10 @@ func (s *ConstantDataService) GetOperatingSystemVersionCriterion() (operatingSys
 		constantDataServiceUrl,
 		"getOperatingSystemVersionCriterion",
 		struct {
-			XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201806 getOperatingSystemVersionCriterion"`
-		}{},
+			XMLName xml.Name
+		}{
+			XMLName: xml.Name{Space: s.Auth.namespace(constantDataServiceUrl), Local: "getOperatingSystemVersionCriterion"},
+		},
 	)
 	if err != nil {
 		return operatingSystemVersions, err
@@ -191,8 +203,10 @@ func (s *ConstantDataService) GetUserInterestCriterion() (userInterests []UserIn
 		constantDataServiceUrl,
 		"getUserInterestCriterion",
 		struct {
-			XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201806 getUserInterestCriterion"`
-		}{},
+			XMLName xml.Name
+		}{
+			XMLName: xml.Name{Space: s.Auth.namespace(constantDataServiceUrl), Local: "getUserInterestCriterion"},
+		},
 	)
 	if err != nil {
 		return userInterests, err
@@ -212,8 +226,10 @@ func (s *ConstantDataService) GetVerticalCriterion() (verticals []VerticalCriter
 		constantDataServiceUrl,
 		"getVerticalCriterion",
 		struct {
-			XMLName xml.Name `xml:"https://adwords.google.com/api/adwords/cm/v201806 getVerticalCriterion"`
-		}{},
+			XMLName xml.Name
+		}{
+			XMLName: xml.Name{Space: s.Auth.namespace(constantDataServiceUrl), Local: "getVerticalCriterion"},
+		},
 	)
 	if err != nil {
 		return verticals, err
@@ -127,7 +127,7 @@ func (s *ConversionTrackerService) Mutate(
 		Ops     []operation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
-			Space: baseUrl,
+			Space: s.Auth.namespace(conversionTrackerServiceUrl),
 			Local: "mutate",
 		},
 		Ops: operations,
@@ -152,11 +152,37 @@ func (s *ConversionTrackerService) Mutate(
 	return mutateResp.ConversionTrackers, err
 }
 
+// ConversionTrackerFields lists the fields of the conversion trackers the
+// ConversionTrackerService selects and filters on, see FieldCatalog.
+var ConversionTrackerFields = FieldCatalog{
+	Service: "ConversionTrackerService",
+	Selectable: []string{
+		"Id", "OriginalConversionTypeId", "Name", "Status", "Category", "GoogleEventSnippet",
+		"GoogleGlobalSiteTag", "DataDrivenModelStatus", "ConversionTypeOwnerCustomerId",
+		"ViewthroughLookbackWindow", "CtcLookbackWindow", "CountingType", "DefaultRevenueValue",
+		"DefaultRevenueCurrencyCode", "AlwaysUseDefaultRevenueValue", "ExcludeFromBidding",
+		"AttributionModelType", "MostRecentConversionDate", "LastReceivedRequestTime",
+		"TrackingCodeType", "AppId", "AppPlatform", "SnippetType", "AppConversionType",
+		"AppPostbackUrl", "IsExternallyAttributed", "PhoneCallDuration",
+	},
+	Filterable: []string{
+		"Id", "OriginalConversionTypeId", "Name", "Status", "Category", "DataDrivenModelStatus",
+		"ConversionTypeOwnerCustomerId", "ViewthroughLookbackWindow", "CtcLookbackWindow",
+		"CountingType", "DefaultRevenueValue", "DefaultRevenueCurrencyCode",
+		"AlwaysUseDefaultRevenueValue", "ExcludeFromBidding", "AttributionModelType",
+		"MostRecentConversionDate", "LastReceivedRequestTime", "TrackingCodeType", "AppId",
+		"AppPlatform", "AppConversionType", "IsExternallyAttributed", "PhoneCallDuration",
+	},
+}
+
 func (s *ConversionTrackerService) Get(selector Selector) (
 	items ConversionTrackers,
 	totalCount int64,
 	err error,
 ) {
+	if err := ConversionTrackerFields.Validate(selector); err != nil {
+		return items, totalCount, err
+	}
 	selector.XMLName = xml.Name{"", "serviceSelector"}
 	respBody, err := s.Auth.request(
 		conversionTrackerServiceUrl,
@@ -166,7 +192,7 @@ func (s *ConversionTrackerService) Get(selector Selector) (
 			Sel     Selector
 		}{
 			XMLName: xml.Name{
-				Space: baseUrl,
+				Space: s.Auth.namespace(conversionTrackerServiceUrl),
 				Local: "get",
 			},
 			Sel: selector,
@@ -186,3 +212,8 @@ func (s *ConversionTrackerService) Get(selector Selector) (
 	}
 	return getResp.Items, getResp.Size, err
 }
+
+// All returns a Pager over all the conversion trackers matching selector.
+func (s *ConversionTrackerService) All(selector Selector) *Pager[ConversionTracker] {
+	return NewPager(s.Get, selector)
+}
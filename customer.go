@@ -45,7 +45,7 @@ func (m *CustomerService) GetCustomers(s *Selector) (
 			Sel     *Selector
 		}{
 			XMLName: xml.Name{
-				Space: managedCustomerUrl,
+				Space: m.Auth.namespace(customerServiceUrl),
 				Local: "getCustomers",
 			},
 			Sel: s,
@@ -76,7 +76,7 @@ func (m *CustomerService) Mutate(c Customer) (customer Customer, err error) {
 		Customer Customer `xml:"customer"`
 	}{
 		XMLName: xml.Name{
-			Space: managedCustomerUrl,
+			Space: m.Auth.namespace(customerServiceUrl),
 			Local: "mutate",
 		},
 		Customer: c,
@@ -126,7 +126,7 @@ func (m *CustomerService) GetServiceLinks(s *Selector) (serviceLinks []ServiceLi
 			Sel     *Selector
 		}{
 			XMLName: xml.Name{
-				Space: managedCustomerUrl,
+				Space: m.Auth.namespace(customerServiceUrl),
 				Local: "getServiceLinks",
 			},
 			Sel: s,
@@ -153,13 +153,13 @@ type ServiceLinkOperations map[string][]ServiceLink
 
 func (s *CustomerService) MutateServiceLinks(ops ServiceLinkOperations) (links []ServiceLink, err error) {
 	type linkOperation struct {
-		Action      string      `xml:"https://adwords.google.com/api/adwords/cm/v201806 operator"`
+		Action      cmOperator
 		ServiceLink ServiceLink `xml:"operand"`
 	}
 	operations := []linkOperation{}
 	for action, links := range ops {
 		for _, link := range links {
-			operations = append(operations, linkOperation{Action: action, ServiceLink: link})
+			operations = append(operations, linkOperation{Action: s.Auth.cmOperator(action), ServiceLink: link})
 		}
 	}
 	respBody, err := s.Auth.request(
@@ -170,7 +170,7 @@ func (s *CustomerService) MutateServiceLinks(ops ServiceLinkOperations) (links [
 			Ops     []linkOperation `xml:"operations"`
 		}{
 			XMLName: xml.Name{
-				Space: managedCustomerUrl,
+				Space: s.Auth.namespace(customerServiceUrl),
 				Local: "mutateServiceLinks",
 			},
 			Ops: operations,
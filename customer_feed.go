@@ -1,5 +1,9 @@
 package gads
 
+import (
+	"encoding/xml"
+)
+
 type CustomerFeedService struct {
 	Auth
 }
@@ -7,3 +11,190 @@ type CustomerFeedService struct {
 func NewCustomerFeedService(auth *Auth) *CustomerFeedService {
 	return &CustomerFeedService{Auth: *auth}
 }
+
+// CustomerFeed links a feed to the whole account, the matching function
+// selects the feed items served with all its ads.
+//
+// PlaceholderTypes: see the PlaceholderType constants
+// Status: ENABLED, REMOVED
+type CustomerFeed struct {
+	FeedId           int64     `xml:"feedId"`
+	MatchingFunction *Function `xml:"matchingFunction,omitempty"`
+	PlaceholderTypes []int64   `xml:"placeholderTypes,omitempty"`
+	Status           string    `xml:"status,omitempty"`
+}
+
+// CustomerFeedOperations is a map of operations to perform on
+// CustomerFeed's, customer feeds can only be added and removed.
+type CustomerFeedOperations map[string][]CustomerFeed
+
+// CustomerFeedFields lists the fields of the customer feeds the
+// CustomerFeedService selects and filters on, see FieldCatalog.
+var CustomerFeedFields = FieldCatalog{
+	Service: "CustomerFeedService",
+	Selectable: []string{
+		"FeedId", "MatchingFunction", "PlaceholderTypes", "Status",
+	},
+	Filterable: []string{
+		"FeedId", "PlaceholderTypes", "Status",
+	},
+}
+
+// Get returns an array of CustomerFeed's and the total number of
+// CustomerFeed's matching the selector.
+//
+// Example
+//
+//   customerFeeds, totalCount, err := customerFeedService.Get(
+//     gads.Selector{
+//       Fields: []string{"FeedId", "MatchingFunction", "PlaceholderTypes", "Status"},
+//       Predicates: []gads.Predicate{
+//         {"Status", "EQUALS", []string{"ENABLED"}},
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/CustomerFeedService#get
+//
+func (s *CustomerFeedService) Get(selector Selector) (customerFeeds []CustomerFeed, totalCount int64, err error) {
+	if err := CustomerFeedFields.Validate(selector); err != nil {
+		return customerFeeds, totalCount, err
+	}
+	selector.XMLName = xml.Name{"", "selector"}
+	respBody, err := s.Auth.request(
+		customerFeedServiceUrl,
+		"get",
+		struct {
+			XMLName xml.Name
+			Sel     Selector
+		}{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(customerFeedServiceUrl),
+				Local: "get",
+			},
+			Sel: selector,
+		},
+	)
+	if err != nil {
+		return customerFeeds, totalCount, err
+	}
+	getResp := struct {
+		Size          int64          `xml:"rval>totalNumEntries"`
+		CustomerFeeds []CustomerFeed `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return customerFeeds, totalCount, err
+	}
+	return getResp.CustomerFeeds, getResp.Size, err
+}
+
+// All returns a Pager over all the customer feeds matching selector.
+func (s *CustomerFeedService) All(selector Selector) *Pager[CustomerFeed] {
+	return NewPager(s.Get, selector)
+}
+
+// Mutate allows you to link feeds to the account and unlink them, returning
+// the modified customer feeds.
+//
+// Example
+//
+//   customerFeeds, err := customerFeedService.Mutate(
+//     gads.CustomerFeedOperations{
+//       "ADD": {
+//         gads.CustomerFeed{
+//           FeedId:           feed.Id,
+//           PlaceholderTypes: []int64{gads.PlaceholderTypeLocation},
+//           MatchingFunction: &gads.Function{
+//             Operator:   "IDENTITY",
+//             LhsOperand: gads.FunctionArgumentOperands{gads.NewBooleanConstant(true)},
+//           },
+//         },
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/CustomerFeedService#mutate
+//
+func (s *CustomerFeedService) Mutate(customerFeedOperations CustomerFeedOperations) (customerFeeds []CustomerFeed, err error) {
+	type customerFeedOperation struct {
+		Action       string       `xml:"operator"`
+		CustomerFeed CustomerFeed `xml:"operand"`
+	}
+	operations := []customerFeedOperation{}
+	for action, customerFeeds := range customerFeedOperations {
+		for _, customerFeed := range customerFeeds {
+			operations = append(operations,
+				customerFeedOperation{
+					Action:       action,
+					CustomerFeed: customerFeed,
+				},
+			)
+		}
+	}
+	mutation := struct {
+		XMLName xml.Name
+		Ops     []customerFeedOperation `xml:"operations"`
+	}{
+		XMLName: xml.Name{
+			Space: s.Auth.namespace(customerFeedServiceUrl),
+			Local: "mutate",
+		},
+		Ops: operations,
+	}
+	respBody, err := s.Auth.request(customerFeedServiceUrl, "mutate", mutation)
+	if err != nil {
+		return customerFeeds, err
+	}
+	mutateResp := struct {
+		BaseResponse
+		CustomerFeeds []CustomerFeed `xml:"rval>value"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &mutateResp)
+	if err != nil {
+		return customerFeeds, err
+	}
+
+	if len(mutateResp.PartialFailureErrors) > 0 {
+		err = mutateResp.PartialFailureErrors
+	}
+
+	return mutateResp.CustomerFeeds, err
+}
+
+// Query returns the customer feeds matching the awql query, and their
+// total count regardless of the LIMIT clause.
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/CustomerFeedService#query
+//
+func (s *CustomerFeedService) Query(query string) (customerFeeds []CustomerFeed, totalCount int64, err error) {
+	respBody, err := s.Auth.request(
+		customerFeedServiceUrl,
+		"query",
+		AWQLQuery{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(customerFeedServiceUrl),
+				Local: "query",
+			},
+			Query: query,
+		},
+	)
+	if err != nil {
+		return customerFeeds, totalCount, err
+	}
+	queryResp := struct {
+		Size          int64          `xml:"rval>totalNumEntries"`
+		CustomerFeeds []CustomerFeed `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &queryResp)
+	if err != nil {
+		return customerFeeds, totalCount, err
+	}
+	return queryResp.CustomerFeeds, queryResp.Size, err
+}
@@ -1,5 +1,11 @@
 package gads
 
+import (
+	"encoding/xml"
+	"strings"
+	"time"
+)
+
 type CustomerSyncService struct {
 	Auth
 }
@@ -7,3 +13,141 @@ type CustomerSyncService struct {
 func NewCustomerSyncService(auth *Auth) *CustomerSyncService {
 	return &CustomerSyncService{Auth: *auth}
 }
+
+// CustomerSyncSelector selects the changes of the campaigns or of the
+// feeds during DateTimeRange, which can not start more than 90 days ago.
+type CustomerSyncSelector struct {
+	XMLName       xml.Name
+	DateTimeRange DateTimeRange `xml:"dateTimeRange"`
+	CampaignIds   []int64       `xml:"campaignIds"`
+	FeedIds       []int64       `xml:"feedIds"`
+}
+
+// DateTimeRange is a range of times formatted as "20060102 150405 Zone",
+// see NewDateTimeRange and FormatDateTime.
+type DateTimeRange struct {
+	Min string `xml:"min,omitempty"`
+	Max string `xml:"max,omitempty"`
+}
+
+// NewDateTimeRange returns the range between min and max, formatted by
+// FormatDateTime.
+func NewDateTimeRange(min, max time.Time) DateTimeRange {
+	return DateTimeRange{Min: FormatDateTime(min), Max: FormatDateTime(max)}
+}
+
+// FormatDateTime returns t as "20060102 150405 Zone", the format of the
+// date times of the api, in its location if it is a zone of the IANA
+// database, in UTC otherwise.
+func FormatDateTime(t time.Time) string {
+	if !ianaZone(t) {
+		t = t.UTC()
+	}
+	return t.Format("20060102 150405") + " " + t.Location().String()
+}
+
+// ianaZone tells if the location of t is a zone of the IANA database, the
+// only names the api reads. The local location, the abbreviations and
+// offsets named by time.Parse or time.FixedZone, as EST or +01, are not.
+func ianaZone(t time.Time) bool {
+	name := t.Location().String()
+	if name != "UTC" && !strings.Contains(name, "/") {
+		return false
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return false
+	}
+	_, offset := t.Zone()
+	_, loaded := t.In(loc).Zone()
+	return offset == loaded
+}
+
+// CustomerChangeData lists the entities changed, LastChangeTimestamp is the
+// min of the DateTimeRange of the next sync.
+type CustomerChangeData struct {
+	ChangedCampaigns    []CampaignChangeData `xml:"changedCampaigns"`
+	ChangedFeeds        []FeedChangeData     `xml:"changedFeeds"`
+	LastChangeTimestamp string               `xml:"lastChangeTimestamp"`
+}
+
+// CampaignChangeStatus: FIELDS_UNCHANGED, FIELDS_CHANGED, NEW
+type CampaignChangeData struct {
+	CampaignId              int64               `xml:"campaignId"`
+	CampaignChangeStatus    string              `xml:"campaignChangeStatus"`
+	ChangedAdGroups         []AdGroupChangeData `xml:"changedAdGroups"`
+	AddedCampaignCriteria   []int64             `xml:"addedCampaignCriteria"`
+	RemovedCampaignCriteria []int64             `xml:"removedCampaignCriteria"`
+	ChangedFeeds            []int64             `xml:"changedFeeds"`
+	RemovedFeeds            []int64             `xml:"removedFeeds"`
+}
+
+// AdGroupChangeStatus: FIELDS_UNCHANGED, FIELDS_CHANGED, NEW
+type AdGroupChangeData struct {
+	AdGroupId           int64   `xml:"adGroupId"`
+	AdGroupChangeStatus string  `xml:"adGroupChangeStatus"`
+	ChangedAds          []int64 `xml:"changedAds"`
+	ChangedCriteria     []int64 `xml:"changedCriteria"`
+	RemovedCriteria     []int64 `xml:"removedCriteria"`
+	ChangedFeeds        []int64 `xml:"changedFeeds"`
+	RemovedFeeds        []int64 `xml:"removedFeeds"`
+}
+
+// FeedChangeStatus: FIELDS_UNCHANGED, FIELDS_CHANGED, NEW
+type FeedChangeData struct {
+	FeedId           int64   `xml:"feedId"`
+	FeedChangeStatus string  `xml:"feedChangeStatus"`
+	ChangedFeedItems []int64 `xml:"changedFeedItems"`
+	RemovedFeedItems []int64 `xml:"removedFeedItems"`
+}
+
+// Get returns the ids of the entities changed during the range of the
+// selector, they are to be fetched with the Get of their services.
+//
+// Example
+//
+//   changes, err := customerSyncService.Get(
+//     gads.CustomerSyncSelector{
+//       DateTimeRange: gads.NewDateTimeRange(lastSync, time.Now()),
+//       CampaignIds:   campaignIds,
+//     },
+//   )
+//   for _, campaign := range changes.ChangedCampaigns {
+//     for _, adGroup := range campaign.ChangedAdGroups {
+//       ...
+//     }
+//   }
+//   nextSync := changes.LastChangeTimestamp
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/CustomerSyncService#get
+//
+func (s *CustomerSyncService) Get(selector CustomerSyncSelector) (changes CustomerChangeData, err error) {
+	selector.XMLName = xml.Name{"", "selector"}
+	respBody, err := s.Auth.request(
+		customerSyncServiceUrl,
+		"get",
+		struct {
+			XMLName xml.Name
+			Sel     CustomerSyncSelector
+		}{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(customerSyncServiceUrl),
+				Local: "get",
+			},
+			Sel: selector,
+		},
+	)
+	if err != nil {
+		return changes, err
+	}
+	getResp := struct {
+		Changes CustomerChangeData `xml:"rval"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return changes, err
+	}
+	return getResp.Changes, err
+}
@@ -0,0 +1,123 @@
+package gads
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testCustomerSyncResponse = `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Body>
+    <getResponse xmlns="https://adwords.google.com/api/adwords/ch/v201806">
+      <rval>
+        <changedCampaigns>
+          <campaignId>11</campaignId>
+          <campaignChangeStatus>FIELDS_UNCHANGED</campaignChangeStatus>
+          <changedAdGroups>
+            <adGroupId>21</adGroupId>
+            <adGroupChangeStatus>NEW</adGroupChangeStatus>
+            <changedAds>31</changedAds>
+            <changedAds>32</changedAds>
+            <changedCriteria>41</changedCriteria>
+            <removedCriteria>42</removedCriteria>
+          </changedAdGroups>
+          <addedCampaignCriteria>51</addedCampaignCriteria>
+          <changedFeeds>61</changedFeeds>
+        </changedCampaigns>
+        <changedFeeds>
+          <feedId>61</feedId>
+          <feedChangeStatus>FIELDS_CHANGED</feedChangeStatus>
+          <changedFeedItems>71</changedFeedItems>
+          <removedFeedItems>72</removedFeedItems>
+        </changedFeeds>
+        <lastChangeTimestamp>20180702 101500.123456 Europe/Paris</lastChangeTimestamp>
+      </rval>
+    </getResponse>
+  </soap:Body>
+</soap:Envelope>`
+
+func TestCustomerSync(t *testing.T) {
+	var path, body string
+	auth, cleanup := testServerAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		path, body = r.URL.Path, string(b)
+		fmt.Fprint(w, testCustomerSyncResponse)
+	})
+	defer cleanup()
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip(err)
+	}
+	changes, err := NewCustomerSyncService(&auth).Get(CustomerSyncSelector{
+		DateTimeRange: NewDateTimeRange(time.Date(2018, 7, 1, 0, 0, 0, 0, paris), time.Date(2018, 7, 2, 12, 30, 0, 0, time.UTC)),
+		CampaignIds:   []int64{11, 12},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/ch/v201806/CustomerSyncService" {
+		t.Errorf("unexpected request path %s", path)
+	}
+	for _, expected := range []string{
+		"<min>20180701 000000 Europe/Paris</min>",
+		"<max>20180702 123000 UTC</max>",
+		"<campaignIds>12</campaignIds>",
+	} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("expected %s in the request\n%s", expected, body)
+		}
+	}
+
+	expected := CustomerChangeData{
+		ChangedCampaigns: []CampaignChangeData{{
+			CampaignId:           11,
+			CampaignChangeStatus: "FIELDS_UNCHANGED",
+			ChangedAdGroups: []AdGroupChangeData{{
+				AdGroupId:           21,
+				AdGroupChangeStatus: "NEW",
+				ChangedAds:          []int64{31, 32},
+				ChangedCriteria:     []int64{41},
+				RemovedCriteria:     []int64{42},
+			}},
+			AddedCampaignCriteria: []int64{51},
+			ChangedFeeds:          []int64{61},
+		}},
+		ChangedFeeds: []FeedChangeData{{
+			FeedId:           61,
+			FeedChangeStatus: "FIELDS_CHANGED",
+			ChangedFeedItems: []int64{71},
+			RemovedFeedItems: []int64{72},
+		}},
+		LastChangeTimestamp: "20180702 101500.123456 Europe/Paris",
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("expected %#v, got %#v", expected, changes)
+	}
+}
+
+func TestNewDateTimeRangeUnnamedZone(t *testing.T) {
+	plusOne := time.FixedZone("", 3600)
+	dateTimeRange := NewDateTimeRange(time.Date(2018, 7, 1, 1, 0, 0, 0, plusOne), time.Date(2018, 7, 1, 13, 0, 0, 0, time.Local))
+	if dateTimeRange.Min != "20180701 000000 UTC" {
+		t.Errorf("expected a time without zone name in UTC, got %s", dateTimeRange.Min)
+	}
+	if !strings.HasSuffix(dateTimeRange.Max, " UTC") {
+		t.Errorf("expected a local time in UTC, got %s", dateTimeRange.Max)
+	}
+
+	for _, zoned := range []time.Time{
+		time.Date(2018, 7, 1, 14, 0, 0, 0, time.FixedZone("CEST", 7200)),
+		time.Date(2018, 7, 1, 13, 0, 0, 0, time.FixedZone("+01", 3600)),
+		time.Date(2018, 7, 1, 13, 0, 0, 0, time.FixedZone("Europe/Paris", 3600)),
+		time.Date(2018, 7, 1, 7, 0, 0, 0, time.FixedZone("EST", -5*3600)),
+	} {
+		if formatted := FormatDateTime(zoned); formatted != "20180701 120000 UTC" {
+			t.Errorf("expected %s in UTC, got %s", zoned, formatted)
+		}
+	}
+}
@@ -49,7 +49,7 @@ func (s *DataService) GetCriterionBidLandscape(selector Selector) ([]CriterionBi
 			XMLName xml.Name
 			Sel     Selector
 		}{
-			XMLName: xml.Name{Space: baseUrl, Local: "getCriterionBidLandscape"},
+			XMLName: xml.Name{Space: s.Auth.namespace(dataServiceUrl), Local: "getCriterionBidLandscape"},
 			Sel:     selector,
 		},
 	)
@@ -67,6 +67,11 @@ func (s *DataService) GetCriterionBidLandscape(selector Selector) ([]CriterionBi
 	return getResp.CriterionBidLandscapes, getResp.Size, err
 }
 
+// AllCriterionBidLandscape returns a Pager over all the ad group criterion bid landscapes matching selector.
+func (s *DataService) AllCriterionBidLandscape(selector Selector) *Pager[CriterionBidLandscape] {
+	return NewPager(s.GetCriterionBidLandscape, selector)
+}
+
 // GetCampaignCriterionBidLandscape returns CriterionBidLandscape
 func (s *DataService) GetCampaignCriterionBidLandscape(selector Selector) ([]CriterionBidLandscape, int64, error) {
 	selector.XMLName = xml.Name{Space: "", Local: "serviceSelector"}
@@ -77,7 +82,7 @@ func (s *DataService) GetCampaignCriterionBidLandscape(selector Selector) ([]Cri
 			XMLName xml.Name
 			Sel     Selector
 		}{
-			XMLName: xml.Name{Space: baseUrl, Local: "getCampaignCriterionBidLandscape"},
+			XMLName: xml.Name{Space: s.Auth.namespace(dataServiceUrl), Local: "getCampaignCriterionBidLandscape"},
 			Sel:     selector,
 		},
 	)
@@ -94,3 +99,8 @@ func (s *DataService) GetCampaignCriterionBidLandscape(selector Selector) ([]Cri
 	}
 	return getResp.CriterionBidLandscapes, getResp.Size, err
 }
+
+// AllCampaignCriterionBidLandscape returns a Pager over all the campaign criterion bid landscapes matching selector.
+func (s *DataService) AllCampaignCriterionBidLandscape(selector Selector) *Pager[CriterionBidLandscape] {
+	return NewPager(s.GetCampaignCriterionBidLandscape, selector)
+}
@@ -22,6 +22,20 @@
 //       },
 //     )
 //
+// Requests can be bound to a context.Context, in order to cancel them or
+// give them a deadline, by creating the service from an Auth returned by
+// WithContext.
+//
+//     ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+//     defer cancel()
+//     campaignService := gads.NewCampaignService(authConf.Auth.WithContext(ctx))
+//
+// The api version and the root url of the api are set per Auth, to migrate
+// accounts one by one or to work against a local server.
+//
+//     authConf.Auth.APIVersion = "v201809"
+//     authConf.Auth.Endpoint = "http://localhost:8080/api/adwords"
+//
 // 1. http://www.google.com/adwords/myclientcenter/
 //
 // 2. https://developers.google.com/adwords/api/docs/signingup
@@ -65,3 +65,33 @@ func (p *PartialFailureError) GetRequestOffset() (int, error) {
 	return offset, nil
 
 }
+
+// mutateInBatches sends n operations with partial failure, calling mutate
+// with the bounds of each batch of batchSize operations. The operations
+// failing alone are passed to reject with their index, the others are
+// counted in applied. A batch failing as a whole stops the mutations, its
+// error being returned.
+func mutateInBatches(n, batchSize int, mutate func(start, end int) error, reject func(index int, err error)) (applied int, err error) {
+	for start := 0; start < n; start += batchSize {
+		end := start + batchSize
+		if end > n {
+			end = n
+		}
+		err := mutate(start, end)
+		partialErrors, partial := err.(PartialFailureErrors)
+		if err != nil && !partial {
+			return applied, err
+		}
+		failed := map[int]bool{}
+		for _, partialError := range partialErrors {
+			offset, err := partialError.GetRequestOffset()
+			if err != nil || offset >= end-start {
+				return applied, partialErrors
+			}
+			failed[offset] = true
+			reject(start+offset, partialError)
+		}
+		applied += end - start - len(failed)
+	}
+	return applied, nil
+}
@@ -75,6 +75,15 @@ type RateExceededError struct {
 	RetryAfterSeconds uint   `xml:"retryAfterSeconds"` // Try again in...
 }
 
+// InternalApiError is raised when an unexpected error occurs on the server side,
+// the request can usually be retried later on.
+type InternalApiError struct {
+	FieldPath   string `xml:"fieldPath"`
+	Trigger     string `xml:"trigger"`
+	ErrorString string `xml:"errorString"`
+	Reason      string `xml:"reason"` // UNEXPECTED_INTERNAL_API_ERROR, TRANSIENT_ERROR, UNKNOWN, DOWNTIME, ERROR_GENERATING_RESPONSE
+}
+
 type UnknownError struct {
 	FieldPath   string `xml:"fieldPath"`
 	Trigger     string `xml:"trigger"`
@@ -132,6 +141,10 @@ func (aes *ApiExceptionFault) UnmarshalXML(dec *xml.Decoder, start xml.StartElem
 					e := RateExceededError{}
 					dec.DecodeElement(&e, &start)
 					aes.Errors = append(aes.Errors, e)
+				case "InternalApiError":
+					e := InternalApiError{}
+					dec.DecodeElement(&e, &start)
+					aes.Errors = append(aes.Errors, e)
 				default:
 					e := UnknownError{}
 					dec.DecodeElement(&e, &start)
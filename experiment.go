@@ -1,5 +1,13 @@
 package gads
 
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+)
+
+// ExperimentService is gone from the api, campaign experiments are now run
+// with the DraftService and the TrialService.
 type ExperimentService struct {
 	Auth
 }
@@ -7,3 +15,428 @@ type ExperimentService struct {
 func NewExperimentService(auth *Auth) *ExperimentService {
 	return &ExperimentService{Auth: *auth}
 }
+
+type DraftService struct {
+	Auth
+}
+
+func NewDraftService(auth *Auth) *DraftService {
+	return &DraftService{Auth: *auth}
+}
+
+type TrialService struct {
+	Auth
+}
+
+func NewTrialService(auth *Auth) *TrialService {
+	return &TrialService{Auth: *auth}
+}
+
+// DraftStatus is the status of a Draft
+type DraftStatus string
+
+const (
+	DraftStatusProposed      DraftStatus = "PROPOSED"
+	DraftStatusArchived      DraftStatus = "ARCHIVED"
+	DraftStatusPromoting     DraftStatus = "PROMOTING"
+	DraftStatusPromoted      DraftStatus = "PROMOTED"
+	DraftStatusPromoteFailed DraftStatus = "PROMOTE_FAILED"
+)
+
+// TrialStatus is the status of a Trial
+type TrialStatus string
+
+const (
+	TrialStatusCreating       TrialStatus = "CREATING"
+	TrialStatusActive         TrialStatus = "ACTIVE"
+	TrialStatusPaused         TrialStatus = "PAUSED"
+	TrialStatusCreationFailed TrialStatus = "CREATION_FAILED"
+	TrialStatusPromoting      TrialStatus = "PROMOTING"
+	TrialStatusPromoted       TrialStatus = "PROMOTED"
+	TrialStatusPromoteFailed  TrialStatus = "PROMOTE_FAILED"
+	TrialStatusGraduated      TrialStatus = "GRADUATED"
+	TrialStatusArchived       TrialStatus = "ARCHIVED"
+	TrialStatusHalted         TrialStatus = "HALTED"
+)
+
+// Pending tells if the trial is still being created or promoted
+func (s TrialStatus) Pending() bool {
+	return s == TrialStatusCreating || s == TrialStatusPromoting
+}
+
+// TrafficSplitType is the way the traffic is split between the base
+// campaign and the trial
+type TrafficSplitType string
+
+const (
+	TrafficSplitRandomQuery TrafficSplitType = "RANDOM_QUERY"
+	TrafficSplitCookie      TrafficSplitType = "COOKIE"
+)
+
+// Draft is a copy of a campaign whose changes are not served. The draft
+// campaign, its ad groups, ads and criteria are edited with their services
+// under the DraftCampaignId, then the draft is promoted or turned into a
+// Trial.
+type Draft struct {
+	BaseCampaignId  int64       `xml:"baseCampaignId"`
+	Id              int64       `xml:"draftId,omitempty"`
+	Name            string      `xml:"draftName,omitempty"`
+	Status          DraftStatus `xml:"draftStatus,omitempty"`
+	DraftCampaignId int64       `xml:"draftCampaignId,omitempty"`
+	HasRunningTrial bool        `xml:"hasRunningTrial,omitempty"`
+}
+
+// DraftOperations is a map of operations to perform on Draft's, drafts can
+// be added and set.
+type DraftOperations map[string][]Draft
+
+// Trial serves a draft to a share of the traffic of its base campaign.
+//
+// StartDate, EndDate: "20060102", the trial ends with the base campaign if
+// EndDate is empty
+// TrafficSplitPercent: share of the traffic served by the trial, 1 to 99
+// BudgetId: budget of the campaign of a graduated trial
+type Trial struct {
+	Id                  int64            `xml:"id,omitempty"`
+	BaseCampaignId      int64            `xml:"baseCampaignId,omitempty"`
+	DraftId             int64            `xml:"draftId,omitempty"`
+	BudgetId            int64            `xml:"budgetId,omitempty"`
+	Name                string           `xml:"name,omitempty"`
+	StartDate           string           `xml:"startDate,omitempty"`
+	EndDate             string           `xml:"endDate,omitempty"`
+	TrafficSplitPercent int              `xml:"trafficSplitPercent,omitempty"`
+	TrafficSplitType    TrafficSplitType `xml:"trafficSplitType,omitempty"`
+	TrialCampaignId     int64            `xml:"trialCampaignId,omitempty"`
+	Status              TrialStatus      `xml:"status,omitempty"`
+}
+
+// TrialOperations is a map of operations to perform on Trial's, trials can
+// be added and set.
+type TrialOperations map[string][]Trial
+
+// DraftFields lists the fields of the drafts the DraftService selects and
+// filters on, see FieldCatalog.
+var DraftFields = FieldCatalog{
+	Service: "DraftService",
+	Selectable: []string{
+		"BaseCampaignId", "DraftId", "DraftName", "DraftStatus", "DraftCampaignId",
+		"HasRunningTrial",
+	},
+	Filterable: []string{
+		"BaseCampaignId", "DraftId", "DraftName", "DraftStatus", "DraftCampaignId",
+		"HasRunningTrial",
+	},
+}
+
+// TrialFields lists the fields of the trials the TrialService selects and
+// filters on, see FieldCatalog.
+var TrialFields = FieldCatalog{
+	Service: "TrialService",
+	Selectable: []string{
+		"Id", "BaseCampaignId", "DraftId", "BudgetId", "Name", "StartDate", "EndDate",
+		"TrafficSplitPercent", "TrafficSplitType", "TrialCampaignId", "Status",
+	},
+	Filterable: []string{
+		"Id", "BaseCampaignId", "DraftId", "BudgetId", "Name", "StartDate", "EndDate",
+		"TrafficSplitPercent", "TrafficSplitType", "TrialCampaignId", "Status",
+	},
+}
+
+// Get returns an array of Draft's and the total number of Draft's matching
+// the selector.
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/DraftService#get
+//
+func (s *DraftService) Get(selector Selector) (drafts []Draft, totalCount int64, err error) {
+	if err := DraftFields.Validate(selector); err != nil {
+		return drafts, totalCount, err
+	}
+	selector.XMLName = xml.Name{"", "selector"}
+	respBody, err := s.Auth.request(
+		draftServiceUrl,
+		"get",
+		struct {
+			XMLName xml.Name
+			Sel     Selector
+		}{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(draftServiceUrl),
+				Local: "get",
+			},
+			Sel: selector,
+		},
+	)
+	if err != nil {
+		return drafts, totalCount, err
+	}
+	getResp := struct {
+		Size   int64   `xml:"rval>totalNumEntries"`
+		Drafts []Draft `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return drafts, totalCount, err
+	}
+	return getResp.Drafts, getResp.Size, err
+}
+
+// All returns a Pager over all the drafts matching selector.
+func (s *DraftService) All(selector Selector) *Pager[Draft] {
+	return NewPager(s.Get, selector)
+}
+
+// Mutate allows you to add drafts, and to rename, archive or promote them
+// by setting their status, returning the modified drafts.
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/DraftService#mutate
+//
+func (s *DraftService) Mutate(draftOperations DraftOperations) (drafts []Draft, err error) {
+	type draftOperation struct {
+		Action string `xml:"operator"`
+		Draft  Draft  `xml:"operand"`
+	}
+	operations := []draftOperation{}
+	for action, drafts := range draftOperations {
+		for _, draft := range drafts {
+			operations = append(operations,
+				draftOperation{
+					Action: action,
+					Draft:  draft,
+				},
+			)
+		}
+	}
+	mutation := struct {
+		XMLName xml.Name
+		Ops     []draftOperation `xml:"operations"`
+	}{
+		XMLName: xml.Name{
+			Space: s.Auth.namespace(draftServiceUrl),
+			Local: "mutate",
+		},
+		Ops: operations,
+	}
+	respBody, err := s.Auth.request(draftServiceUrl, "mutate", mutation)
+	if err != nil {
+		return drafts, err
+	}
+	mutateResp := struct {
+		BaseResponse
+		Drafts []Draft `xml:"rval>value"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &mutateResp)
+	if err != nil {
+		return drafts, err
+	}
+
+	if len(mutateResp.PartialFailureErrors) > 0 {
+		err = mutateResp.PartialFailureErrors
+	}
+
+	return mutateResp.Drafts, err
+}
+
+// mutateDraft applies a single operation and returns the draft modified
+func (s *DraftService) mutateDraft(action string, draft Draft) (Draft, error) {
+	drafts, err := s.Mutate(DraftOperations{action: {draft}})
+	if err != nil {
+		return draft, err
+	}
+	if len(drafts) != 1 {
+		return draft, fmt.Errorf("no draft returned")
+	}
+	return drafts[0], nil
+}
+
+// Create returns a new draft of the campaign, edit its DraftCampaignId
+// before promoting it or creating a trial.
+//
+// Example
+//
+//   draft, err := draftService.Create(campaign.Id, "target cpa")
+//   adGroups, err := adGroupService.Mutate(gads.AdGroupOperations{
+//     "SET": {{Id: draftAdGroupId, CampaignId: draft.DraftCampaignId, ...}},
+//   })
+//   trial, err := trialService.Create(draft, "target cpa 50/50", 50)
+//
+func (s *DraftService) Create(baseCampaignId int64, name string) (Draft, error) {
+	return s.mutateDraft("ADD", Draft{BaseCampaignId: baseCampaignId, Name: name})
+}
+
+// Promote applies the changes of the draft to its base campaign, without
+// trial. The promotion is asynchronous, the status of the draft tells
+// when it is over.
+func (s *DraftService) Promote(draft Draft) (Draft, error) {
+	return s.mutateDraft("SET", Draft{BaseCampaignId: draft.BaseCampaignId, Id: draft.Id, Status: DraftStatusPromoting})
+}
+
+// Archive drops the draft
+func (s *DraftService) Archive(draft Draft) (Draft, error) {
+	return s.mutateDraft("SET", Draft{BaseCampaignId: draft.BaseCampaignId, Id: draft.Id, Status: DraftStatusArchived})
+}
+
+// Get returns an array of Trial's and the total number of Trial's matching
+// the selector.
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/TrialService#get
+//
+func (s *TrialService) Get(selector Selector) (trials []Trial, totalCount int64, err error) {
+	if err := TrialFields.Validate(selector); err != nil {
+		return trials, totalCount, err
+	}
+	selector.XMLName = xml.Name{"", "selector"}
+	respBody, err := s.Auth.request(
+		trialServiceUrl,
+		"get",
+		struct {
+			XMLName xml.Name
+			Sel     Selector
+		}{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(trialServiceUrl),
+				Local: "get",
+			},
+			Sel: selector,
+		},
+	)
+	if err != nil {
+		return trials, totalCount, err
+	}
+	getResp := struct {
+		Size   int64   `xml:"rval>totalNumEntries"`
+		Trials []Trial `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return trials, totalCount, err
+	}
+	return getResp.Trials, getResp.Size, err
+}
+
+// All returns a Pager over all the trials matching selector.
+func (s *TrialService) All(selector Selector) *Pager[Trial] {
+	return NewPager(s.Get, selector)
+}
+
+// Mutate allows you to add trials, and to pause, promote, graduate or
+// archive them by setting their status, returning the modified trials.
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/TrialService#mutate
+//
+func (s *TrialService) Mutate(trialOperations TrialOperations) (trials []Trial, err error) {
+	type trialOperation struct {
+		Action string `xml:"operator"`
+		Trial  Trial  `xml:"operand"`
+	}
+	operations := []trialOperation{}
+	for action, trials := range trialOperations {
+		for _, trial := range trials {
+			operations = append(operations,
+				trialOperation{
+					Action: action,
+					Trial:  trial,
+				},
+			)
+		}
+	}
+	mutation := struct {
+		XMLName xml.Name
+		Ops     []trialOperation `xml:"operations"`
+	}{
+		XMLName: xml.Name{
+			Space: s.Auth.namespace(trialServiceUrl),
+			Local: "mutate",
+		},
+		Ops: operations,
+	}
+	respBody, err := s.Auth.request(trialServiceUrl, "mutate", mutation)
+	if err != nil {
+		return trials, err
+	}
+	mutateResp := struct {
+		BaseResponse
+		Trials []Trial `xml:"rval>value"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &mutateResp)
+	if err != nil {
+		return trials, err
+	}
+
+	if len(mutateResp.PartialFailureErrors) > 0 {
+		err = mutateResp.PartialFailureErrors
+	}
+
+	return mutateResp.Trials, err
+}
+
+// mutateTrial applies a single operation and returns the trial modified
+func (s *TrialService) mutateTrial(action string, trial Trial) (Trial, error) {
+	trials, err := s.Mutate(TrialOperations{action: {trial}})
+	if err != nil {
+		return trial, err
+	}
+	if len(trials) != 1 {
+		return trial, fmt.Errorf("no trial returned")
+	}
+	return trials[0], nil
+}
+
+// Create starts a trial of the draft on trafficSplitPercent of the traffic
+// of its base campaign, the queries being split at random. The trial is
+// CREATING until its campaign is ready, see Wait.
+func (s *TrialService) Create(draft Draft, name string, trafficSplitPercent int) (Trial, error) {
+	return s.mutateTrial("ADD", Trial{
+		BaseCampaignId:      draft.BaseCampaignId,
+		DraftId:             draft.Id,
+		Name:                name,
+		TrafficSplitPercent: trafficSplitPercent,
+		TrafficSplitType:    TrafficSplitRandomQuery,
+	})
+}
+
+// Promote applies the changes of the trial to its base campaign, the trial
+// is PROMOTING until they are, see Wait.
+func (s *TrialService) Promote(trialId int64) (Trial, error) {
+	return s.mutateTrial("SET", Trial{Id: trialId, Status: TrialStatusPromoting})
+}
+
+// Graduate turns the trial campaign into an independent campaign spending
+// the budget.
+func (s *TrialService) Graduate(trialId, budgetId int64) (Trial, error) {
+	return s.mutateTrial("SET", Trial{Id: trialId, BudgetId: budgetId, Status: TrialStatusGraduated})
+}
+
+// Wait polls the trial until it is no longer being created or promoted,
+// as set by policy. An error is returned once the trial is still pending
+// after policy.Timeout or when the context of the Auth is done.
+func (s *TrialService) Wait(trialId int64, policy PollPolicy) (trial Trial, err error) {
+	selector := Selector{
+		Fields: TrialFields.Selectable,
+		Predicates: []Predicate{
+			{"Id", "EQUALS", []string{strconv.FormatInt(trialId, 10)}},
+		},
+	}
+	err = policy.poll(s.Auth.context(), func() (bool, error) {
+		trials, _, err := s.Get(selector)
+		if err != nil {
+			return false, err
+		}
+		if len(trials) == 0 {
+			return false, fmt.Errorf("trial %d not found", trialId)
+		}
+		trial = trials[0]
+		return !trial.Status.Pending(), nil
+	})
+	if err == errStillRunning {
+		return trial, fmt.Errorf("trial %d is still %s", trialId, trial.Status)
+	}
+	return trial, err
+}
@@ -0,0 +1,98 @@
+package gads
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testTrialEntries = `
+        <totalNumEntries>1</totalNumEntries>
+        <entries>
+          <id>7</id>
+          <baseCampaignId>1</baseCampaignId>
+          <draftId>3</draftId>
+          <trafficSplitPercent>50</trafficSplitPercent>
+          <trafficSplitType>RANDOM_QUERY</trafficSplitType>
+          <trialCampaignId>8</trialCampaignId>
+          <status>%s</status>
+        </entries>`
+
+func TestExperimentDraftTrial(t *testing.T) {
+	var requests []testRequest
+	polls := 0
+	auth, cleanup := testServiceAuth(t, &requests, func(request testRequest) string {
+		switch {
+		case request.Service == "DraftService":
+			return `<value>
+          <baseCampaignId>1</baseCampaignId>
+          <draftId>3</draftId>
+          <draftName>target cpa</draftName>
+          <draftStatus>PROPOSED</draftStatus>
+          <draftCampaignId>2</draftCampaignId>
+        </value>`
+		case request.Action == "get":
+			polls++
+			status := TrialStatusCreating
+			if polls > 1 {
+				status = TrialStatusActive
+			}
+			return fmt.Sprintf(testTrialEntries, status)
+		default:
+			return `<value><id>7</id><status>CREATING</status></value>`
+		}
+	})
+	defer cleanup()
+
+	draft, err := NewDraftService(&auth).Create(1, "target cpa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if draft.Id != 3 || draft.DraftCampaignId != 2 || draft.Status != DraftStatusProposed {
+		t.Fatalf("unexpected draft %#v", draft)
+	}
+
+	trialService := NewTrialService(&auth)
+	trial, err := trialService.Create(draft, "target cpa 50/50", 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"<operator>ADD</operator>",
+		"<baseCampaignId>1</baseCampaignId>",
+		"<draftId>3</draftId>",
+		"<trafficSplitPercent>50</trafficSplitPercent>",
+		"<trafficSplitType>RANDOM_QUERY</trafficSplitType>",
+	} {
+		if !strings.Contains(requests[1].Body, expected) {
+			t.Errorf("expected %s in the trial creation\n%s", expected, requests[1].Body)
+		}
+	}
+
+	defer func(interval time.Duration) { minPollInterval = interval }(minPollInterval)
+	minPollInterval = time.Millisecond
+	trial, err = trialService.Wait(trial.Id, PollPolicy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if polls != 2 || trial.Status != TrialStatusActive || trial.TrialCampaignId != 8 {
+		t.Errorf("expected the trial to be active after 2 polls, got %#v after %d", trial, polls)
+	}
+
+	if _, err := trialService.Graduate(trial.Id, 9); err != nil {
+		t.Fatal(err)
+	}
+	graduation := requests[len(requests)-1].Body
+	for _, expected := range []string{"<operator>SET</operator>", "<budgetId>9</budgetId>", "<status>GRADUATED</status>"} {
+		if !strings.Contains(graduation, expected) {
+			t.Errorf("expected %s in the graduation\n%s", expected, graduation)
+		}
+	}
+
+	polls = 0
+	_, err = trialService.Wait(trial.Id, PollPolicy{Interval: time.Hour, Timeout: time.Millisecond})
+	if err == nil || !strings.Contains(err.Error(), "still CREATING") {
+		t.Errorf("expected the trial to be reported still creating, got %v", err)
+	}
+}
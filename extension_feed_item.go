@@ -46,3 +46,14 @@ type SitelinkFeedItem struct {
 	FinalUrls           *UrlList `xml:"sitelinkFinalUrls"`
 	TrackingURLTemplate *string  `xml:"sitelinkTrackingUrlTemplate"`
 }
+
+// CallFeedItem represents a call extension.
+//
+// see https://developers.google.com/adwords/api/docs/reference/v201806/CampaignExtensionSettingService.CallFeedItem
+type CallFeedItem struct {
+	*CommonExtensionFeedItem
+	PhoneNumber                   string `xml:"callPhoneNumber"`
+	CountryCode                   string `xml:"callCountryCode"`
+	CallTracking                  *bool  `xml:"callTracking,omitempty"`
+	DisableCallConversionTracking *bool  `xml:"disableCallConversionTracking,omitempty"`
+}
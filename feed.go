@@ -1,5 +1,9 @@
 package gads
 
+import (
+	"encoding/xml"
+)
+
 type FeedService struct {
 	Auth
 }
@@ -7,3 +11,220 @@ type FeedService struct {
 func NewFeedService(auth *Auth) *FeedService {
 	return &FeedService{Auth: *auth}
 }
+
+// Feed is a table of feed items, whose columns are the attributes.
+//
+// Status: ENABLED, REMOVED
+// Origin: USER, ADWORDS
+// SystemFeedGenerationData: set to create a location feed synced with a
+// Google My Business account
+type Feed struct {
+	Id                       int64                   `xml:"id,omitempty"`
+	Name                     string                  `xml:"name,omitempty"`
+	Attributes               []FeedAttribute         `xml:"attributes,omitempty"`
+	Status                   string                  `xml:"status,omitempty"`
+	Origin                   string                  `xml:"origin,omitempty"`
+	SystemFeedGenerationData *PlacesLocationFeedData `xml:"systemFeedGenerationData,omitempty"`
+}
+
+// AttributeType: INT64, DOUBLE, STRING, DATE, URL, BOOLEAN, DATE_TIME,
+// INT64_LIST, DOUBLE_LIST, STRING_LIST, DATE_LIST, URL_LIST, BOOLEAN_LIST,
+// DATE_TIME_LIST, PRICE
+type FeedAttribute struct {
+	Id            int64  `xml:"id,omitempty"`
+	Name          string `xml:"name,omitempty"`
+	AttributeType string `xml:"type,omitempty"`
+	IsPartOfKey   bool   `xml:"isPartOfKey,omitempty"`
+}
+
+// PlacesLocationFeedData links a feed to the locations of a Google My
+// Business account.
+type PlacesLocationFeedData struct {
+	Type                      string    `xml:"xsi:type,attr,omitempty"`
+	OAuthInfo                 OAuthInfo `xml:"oAuthInfo"`
+	EmailAddress              string    `xml:"emailAddress"`
+	BusinessAccountIdentifier string    `xml:"businessAccountIdentifier,omitempty"`
+	BusinessNameFilter        string    `xml:"businessNameFilter,omitempty"`
+	CategoryFilters           []string  `xml:"categoryFilters,omitempty"`
+	LabelFilters              []string  `xml:"labelFilters,omitempty"`
+}
+
+type OAuthInfo struct {
+	HttpMethod              string `xml:"httpMethod"`
+	HttpRequestUrl          string `xml:"httpRequestUrl"`
+	HttpAuthorizationHeader string `xml:"httpAuthorizationHeader"`
+}
+
+// FeedOperations is a map of operations to perform on Feed's
+type FeedOperations map[string][]Feed
+
+// FeedFields lists the fields of the feeds the FeedService selects and
+// filters on, see FieldCatalog.
+var FeedFields = FieldCatalog{
+	Service: "FeedService",
+	Selectable: []string{
+		"Id", "Name", "Attributes", "FeedStatus", "Origin", "SystemFeedGenerationData",
+	},
+	Filterable: []string{
+		"Id", "Name", "FeedStatus", "Origin",
+	},
+}
+
+// Get returns an array of Feed's and the total number of Feed's matching
+// the selector.
+//
+// Example
+//
+//   feeds, totalCount, err := feedService.Get(
+//     gads.Selector{
+//       Fields: []string{"Id", "Name", "Attributes", "FeedStatus"},
+//       Predicates: []gads.Predicate{
+//         {"FeedStatus", "EQUALS", []string{"ENABLED"}},
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/FeedService#get
+//
+func (s *FeedService) Get(selector Selector) (feeds []Feed, totalCount int64, err error) {
+	if err := FeedFields.Validate(selector); err != nil {
+		return feeds, totalCount, err
+	}
+	selector.XMLName = xml.Name{"", "selector"}
+	respBody, err := s.Auth.request(
+		feedServiceUrl,
+		"get",
+		struct {
+			XMLName xml.Name
+			Sel     Selector
+		}{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(feedServiceUrl),
+				Local: "get",
+			},
+			Sel: selector,
+		},
+	)
+	if err != nil {
+		return feeds, totalCount, err
+	}
+	getResp := struct {
+		Size  int64  `xml:"rval>totalNumEntries"`
+		Feeds []Feed `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return feeds, totalCount, err
+	}
+	return getResp.Feeds, getResp.Size, err
+}
+
+// All returns a Pager over all the feeds matching selector.
+func (s *FeedService) All(selector Selector) *Pager[Feed] {
+	return NewPager(s.Get, selector)
+}
+
+// Mutate allows you to add feeds, add attributes to them and remove them,
+// returning the modified feeds.
+//
+// Example
+//
+//   feeds, err := feedService.Mutate(
+//     gads.FeedOperations{
+//       "ADD": {
+//         gads.Feed{
+//           Name: "ad customizers",
+//           Attributes: []gads.FeedAttribute{
+//             {Name: "Name", AttributeType: "STRING", IsPartOfKey: true},
+//             {Name: "Price", AttributeType: "PRICE"},
+//             {Name: "Date", AttributeType: "DATE_TIME"},
+//           },
+//         },
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/FeedService#mutate
+//
+func (s *FeedService) Mutate(feedOperations FeedOperations) (feeds []Feed, err error) {
+	type feedOperation struct {
+		Action string `xml:"operator"`
+		Feed   Feed   `xml:"operand"`
+	}
+	operations := []feedOperation{}
+	for action, feeds := range feedOperations {
+		for _, feed := range feeds {
+			operations = append(operations,
+				feedOperation{
+					Action: action,
+					Feed:   feed,
+				},
+			)
+		}
+	}
+	mutation := struct {
+		XMLName xml.Name
+		Ops     []feedOperation `xml:"operations"`
+	}{
+		XMLName: xml.Name{
+			Space: s.Auth.namespace(feedServiceUrl),
+			Local: "mutate",
+		},
+		Ops: operations,
+	}
+	respBody, err := s.Auth.request(feedServiceUrl, "mutate", mutation)
+	if err != nil {
+		return feeds, err
+	}
+	mutateResp := struct {
+		BaseResponse
+		Feeds []Feed `xml:"rval>value"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &mutateResp)
+	if err != nil {
+		return feeds, err
+	}
+
+	if len(mutateResp.PartialFailureErrors) > 0 {
+		err = mutateResp.PartialFailureErrors
+	}
+
+	return mutateResp.Feeds, err
+}
+
+// Query returns the feeds matching the awql query, and their total count
+// regardless of the LIMIT clause.
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/FeedService#query
+//
+func (s *FeedService) Query(query string) (feeds []Feed, totalCount int64, err error) {
+	respBody, err := s.Auth.request(
+		feedServiceUrl,
+		"query",
+		AWQLQuery{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(feedServiceUrl),
+				Local: "query",
+			},
+			Query: query,
+		},
+	)
+	if err != nil {
+		return feeds, totalCount, err
+	}
+	queryResp := struct {
+		Size  int64  `xml:"rval>totalNumEntries"`
+		Feeds []Feed `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &queryResp)
+	if err != nil {
+		return feeds, totalCount, err
+	}
+	return queryResp.Feeds, queryResp.Size, err
+}
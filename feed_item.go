@@ -6,13 +6,24 @@ type FeedItemService struct {
 	Auth
 }
 
+// DevicePreference: 30001 to prefer mobile devices, nil for no preference
+// GeoTargeting: location the item is restricted to, see FeedItemTargetService
+// for the ad group and campaign targeting
 type FeedItem struct {
-	FeedID          int64                    `xml:"feedId"`
-	FeedItemID      int64                    `xml:"feedItemId,omitempty"`
-	Status          string                   `xml:"status,omitempty"`
-	StartTime       string                   `xml:"startTime,omitempty"`
-	EndTime         string                   `xml:"endTime,omitempty"`
-	AttributeValues []FeedItemAttributeValue `xml:"attributeValues"`
+	FeedID                  int64                    `xml:"feedId"`
+	FeedItemID              int64                    `xml:"feedItemId,omitempty"`
+	Status                  string                   `xml:"status,omitempty"`
+	StartTime               string                   `xml:"startTime,omitempty"`
+	EndTime                 string                   `xml:"endTime,omitempty"`
+	AttributeValues         []FeedItemAttributeValue `xml:"attributeValues"`
+	DevicePreference        *int64                   `xml:"devicePreference>devicePreference,omitempty"`
+	GeoTargeting            *Location                `xml:"geoTargeting,omitempty"`
+	GeoTargetingRestriction *FeedItemGeoRestriction  `xml:"geoTargetingRestriction,omitempty"`
+}
+
+// GeoRestriction: LOCATION_OF_PRESENCE
+type FeedItemGeoRestriction struct {
+	GeoRestriction string `xml:"geoRestriction"`
 }
 
 type FeedItemAttributeValue struct {
@@ -29,30 +40,41 @@ type FeedItemAttributeValue struct {
 
 type FeedItemOperations map[string][]FeedItem
 
+// NewSitelinkFeedItemAttributeText uses the placeholder field id as feed
+// attribute id, which only holds for feeds whose attributes were created in
+// the order of the fields.
+//
+// Deprecated: use FeedMapping.AttributeValue with SitelinkFieldLinkText.
 func NewSitelinkFeedItemAttributeText(value string) FeedItemAttributeValue {
 	return FeedItemAttributeValue{AttributeID: 1, StringValue: &value}
 }
 
+// Deprecated: use FeedMapping.AttributeValue with SitelinkFieldUrl.
 func NewSitelinkFeedItemAttributeURL(value string) FeedItemAttributeValue {
 	return FeedItemAttributeValue{AttributeID: 2, StringValue: &value}
 }
 
+// Deprecated: use FeedMapping.AttributeValue with SitelinkFieldLine2.
 func NewSitelinkFeedItemAttributeLine1(value string) FeedItemAttributeValue {
 	return FeedItemAttributeValue{AttributeID: 3, StringValue: &value}
 }
 
+// Deprecated: use FeedMapping.AttributeValue with SitelinkFieldLine3.
 func NewSitelinkFeedItemAttributeLine2(value string) FeedItemAttributeValue {
 	return FeedItemAttributeValue{AttributeID: 4, StringValue: &value}
 }
 
+// Deprecated: use FeedMapping.AttributeValue with SitelinkFieldFinalUrls.
 func NewSitelinkFeedItemAttributeFinalURLs(value []string) FeedItemAttributeValue {
 	return FeedItemAttributeValue{AttributeID: 5, StringValues: &value}
 }
 
+// Deprecated: use FeedMapping.AttributeValue with SitelinkFieldFinalMobileUrls.
 func NewSitelinkFeedItemAttributeFinalMobileURLs(value []string) FeedItemAttributeValue {
 	return FeedItemAttributeValue{AttributeID: 6, StringValues: &value}
 }
 
+// Deprecated: use FeedMapping.AttributeValue with SitelinkFieldTrackingUrl.
 func NewSitelinkFeedItemAttributeTrackingURL(value string) FeedItemAttributeValue {
 	return FeedItemAttributeValue{AttributeID: 7, StringValue: &value}
 }
@@ -61,7 +83,21 @@ func NewFeedItemService(auth *Auth) *FeedItemService {
 	return &FeedItemService{Auth: *auth}
 }
 
+// FeedItemFields lists the fields of the feed items the FeedItemService
+// selects and filters on, see FieldCatalog.
+var FeedItemFields = FieldCatalog{
+	Service: "FeedItemService",
+	Selectable: []string{
+		"FeedId", "FeedItemId", "Status", "StartTime", "EndTime", "AttributeValues",
+		"PolicySummaries", "GeoTargetingRestriction", "UrlCustomParameters",
+	},
+	Filterable: []string{"FeedId", "FeedItemId", "Status"},
+}
+
 func (s *FeedItemService) Get(selector Selector) (feedItems []FeedItem, totalCount int64, err error) {
+	if err := FeedItemFields.Validate(selector); err != nil {
+		return feedItems, totalCount, err
+	}
 	selector.XMLName = xml.Name{"", "selector"}
 	respBody, err := s.Auth.request(
 		feedItemServiceUrl,
@@ -71,7 +107,7 @@ func (s *FeedItemService) Get(selector Selector) (feedItems []FeedItem, totalCou
 			Sel     Selector
 		}{
 			XMLName: xml.Name{
-				Space: baseUrl,
+				Space: s.Auth.namespace(feedItemServiceUrl),
 				Local: "get",
 			},
 			Sel: selector,
@@ -91,6 +127,11 @@ func (s *FeedItemService) Get(selector Selector) (feedItems []FeedItem, totalCou
 	return getResp.FeedItems, getResp.Size, err
 }
 
+// All returns a Pager over all the feed items matching selector.
+func (s *FeedItemService) All(selector Selector) *Pager[FeedItem] {
+	return NewPager(s.Get, selector)
+}
+
 func (s *FeedItemService) Mutate(feedItemOperations FeedItemOperations) (feedItems []FeedItem, err error) {
 	type feedItemOperation struct {
 		Action   string   `xml:"operator"`
@@ -106,7 +147,7 @@ func (s *FeedItemService) Mutate(feedItemOperations FeedItemOperations) (feedIte
 		XMLName xml.Name
 		Ops     []feedItemOperation `xml:"operations"`
 	}{
-		XMLName: xml.Name{Space: baseUrl, Local: "mutate"},
+		XMLName: xml.Name{Space: s.Auth.namespace(feedItemServiceUrl), Local: "mutate"},
 		Ops:     operations,
 	}
 	respBody, err := s.Auth.request(feedItemServiceUrl, "mutate", mutation)
@@ -0,0 +1,207 @@
+package gads
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+type FeedItemTargetService struct {
+	Auth
+}
+
+func NewFeedItemTargetService(auth *Auth) *FeedItemTargetService {
+	return &FeedItemTargetService{Auth: *auth}
+}
+
+// FeedItemTarget restricts the serving of a feed item, it is a
+// FeedItemAdGroupTarget or a FeedItemCampaignTarget.
+type FeedItemTarget interface {
+	GetFeedItemID() int64
+	GetType() string
+}
+
+// Status: ACTIVE, REMOVED
+type FeedItemAdGroupTarget struct {
+	Type       string `xml:"xsi:type,attr,omitempty"`
+	FeedID     int64  `xml:"feedId"`
+	FeedItemID int64  `xml:"feedItemId"`
+	TargetType string `xml:"targetType,omitempty"`
+	Status     string `xml:"status,omitempty"`
+	AdGroupId  int64  `xml:"adGroupId"`
+}
+
+func (t FeedItemAdGroupTarget) GetFeedItemID() int64 {
+	return t.FeedItemID
+}
+
+func (t FeedItemAdGroupTarget) GetType() string {
+	return "FeedItemAdGroupTarget"
+}
+
+// Status: ACTIVE, REMOVED
+type FeedItemCampaignTarget struct {
+	Type       string `xml:"xsi:type,attr,omitempty"`
+	FeedID     int64  `xml:"feedId"`
+	FeedItemID int64  `xml:"feedItemId"`
+	TargetType string `xml:"targetType,omitempty"`
+	Status     string `xml:"status,omitempty"`
+	CampaignId int64  `xml:"campaignId"`
+}
+
+func (t FeedItemCampaignTarget) GetFeedItemID() int64 {
+	return t.FeedItemID
+}
+
+func (t FeedItemCampaignTarget) GetType() string {
+	return "FeedItemCampaignTarget"
+}
+
+type FeedItemTargets []FeedItemTarget
+
+func (targets *FeedItemTargets) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	targetType, err := findAttr(start.Attr, xml.Name{Space: "http://www.w3.org/2001/XMLSchema-instance", Local: "type"})
+	if err != nil {
+		return err
+	}
+	switch targetType {
+	case "FeedItemAdGroupTarget":
+		t := FeedItemAdGroupTarget{Type: targetType}
+		if err := dec.DecodeElement(&t, &start); err != nil {
+			return err
+		}
+		*targets = append(*targets, t)
+	case "FeedItemCampaignTarget":
+		t := FeedItemCampaignTarget{Type: targetType}
+		if err := dec.DecodeElement(&t, &start); err != nil {
+			return err
+		}
+		*targets = append(*targets, t)
+	default:
+		if StrictMode {
+			return fmt.Errorf("unknown feed item target type %#v", targetType)
+		}
+		return dec.Skip()
+	}
+	return nil
+}
+
+// FeedItemTargetOperations is a map of operations to perform on
+// FeedItemTarget's, targets can only be added and removed.
+type FeedItemTargetOperations map[string]FeedItemTargets
+
+// Get returns an array of FeedItemTarget's and the total number of
+// FeedItemTarget's matching the selector.
+//
+// Example
+//
+//   targets, totalCount, err := feedItemTargetService.Get(
+//     gads.Selector{
+//       Fields: []string{"FeedId", "FeedItemId", "TargetType", "Status", "AdGroupId", "CampaignId"},
+//       Predicates: []gads.Predicate{
+//         {"FeedId", "EQUALS", []string{feedId}},
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/FeedItemTargetService#get
+//
+func (s *FeedItemTargetService) Get(selector Selector) (targets FeedItemTargets, totalCount int64, err error) {
+	selector.XMLName = xml.Name{"", "selector"}
+	respBody, err := s.Auth.request(
+		feedItemTargetServiceUrl,
+		"get",
+		struct {
+			XMLName xml.Name
+			Sel     Selector
+		}{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(feedItemTargetServiceUrl),
+				Local: "get",
+			},
+			Sel: selector,
+		},
+	)
+	if err != nil {
+		return targets, totalCount, err
+	}
+	getResp := struct {
+		Size    int64           `xml:"rval>totalNumEntries"`
+		Targets FeedItemTargets `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return targets, totalCount, err
+	}
+	return getResp.Targets, getResp.Size, err
+}
+
+// All returns a Pager over all the feed item targets matching selector.
+func (s *FeedItemTargetService) All(selector Selector) *Pager[FeedItemTarget] {
+	return NewPager(s.Get, selector)
+}
+
+// Mutate allows you to restrict feed items to ad groups and campaigns,
+// returning the modified targets.
+//
+// Example
+//
+//   targets, err := feedItemTargetService.Mutate(
+//     gads.FeedItemTargetOperations{
+//       "ADD": {
+//         gads.FeedItemAdGroupTarget{FeedID: feedId, FeedItemID: feedItemId, AdGroupId: adGroupId},
+//         gads.FeedItemCampaignTarget{FeedID: feedId, FeedItemID: otherFeedItemId, CampaignId: campaignId},
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/FeedItemTargetService#mutate
+//
+func (s *FeedItemTargetService) Mutate(targetOperations FeedItemTargetOperations) (targets FeedItemTargets, err error) {
+	type feedItemTargetOperation struct {
+		Action string         `xml:"operator"`
+		Target FeedItemTarget `xml:"operand"`
+	}
+	operations := []feedItemTargetOperation{}
+	for action, targets := range targetOperations {
+		for _, target := range targets {
+			operations = append(operations,
+				feedItemTargetOperation{
+					Action: action,
+					Target: target,
+				},
+			)
+		}
+	}
+	mutation := struct {
+		XMLName xml.Name
+		Ops     []feedItemTargetOperation `xml:"operations"`
+	}{
+		XMLName: xml.Name{
+			Space: s.Auth.namespace(feedItemTargetServiceUrl),
+			Local: "mutate",
+		},
+		Ops: operations,
+	}
+	respBody, err := s.Auth.request(feedItemTargetServiceUrl, "mutate", mutation)
+	if err != nil {
+		return targets, err
+	}
+	mutateResp := struct {
+		BaseResponse
+		Targets FeedItemTargets `xml:"rval>value"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &mutateResp)
+	if err != nil {
+		return targets, err
+	}
+
+	if len(mutateResp.PartialFailureErrors) > 0 {
+		err = mutateResp.PartialFailureErrors
+	}
+
+	return mutateResp.Targets, err
+}
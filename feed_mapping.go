@@ -1,5 +1,10 @@
 package gads
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 type FeedMappingService struct {
 	Auth
 }
@@ -7,3 +12,300 @@ type FeedMappingService struct {
 func NewFeedMappingService(auth *Auth) *FeedMappingService {
 	return &FeedMappingService{Auth: *auth}
 }
+
+// Placeholder types of the feed mappings, they tell what the items of a
+// feed are used for.
+//
+//   https://developers.google.com/adwords/api/docs/appendix/placeholders
+//
+const (
+	PlaceholderTypeSitelink          int64 = 1
+	PlaceholderTypeCall              int64 = 2
+	PlaceholderTypeApp               int64 = 3
+	PlaceholderTypeLocation          int64 = 7
+	PlaceholderTypeAdCustomizer      int64 = 10
+	PlaceholderTypeCallout           int64 = 17
+	PlaceholderTypeStructuredSnippet int64 = 24
+	PlaceholderTypeAffiliateLocation int64 = 30
+	PlaceholderTypeMessage           int64 = 31
+	PlaceholderTypePrice             int64 = 35
+	PlaceholderTypePromotion         int64 = 38
+)
+
+// CriterionTypePageFeed is the criterion type of the feed mappings of the
+// page feeds of dynamic search ads.
+const CriterionTypePageFeed int64 = 61
+
+// Placeholder field ids of the sitelinks
+const (
+	SitelinkFieldLinkText        int64 = 1
+	SitelinkFieldUrl             int64 = 2
+	SitelinkFieldLine2           int64 = 3
+	SitelinkFieldLine3           int64 = 4
+	SitelinkFieldFinalUrls       int64 = 5
+	SitelinkFieldFinalMobileUrls int64 = 6
+	SitelinkFieldTrackingUrl     int64 = 7
+	SitelinkFieldFinalUrlSuffix  int64 = 8
+)
+
+// Placeholder field ids of the locations
+const (
+	LocationFieldBusinessName int64 = 1
+	LocationFieldAddressLine1 int64 = 2
+	LocationFieldAddressLine2 int64 = 3
+	LocationFieldCity         int64 = 4
+	LocationFieldProvince     int64 = 5
+	LocationFieldPostalCode   int64 = 6
+	LocationFieldCountryCode  int64 = 7
+	LocationFieldPhoneNumber  int64 = 8
+)
+
+// Criterion field ids of the page feeds
+const (
+	PageFeedFieldPageUrl int64 = 1
+	PageFeedFieldLabel   int64 = 2
+)
+
+// FeedMapping maps the attributes of a feed to the fields of a placeholder
+// type, or of a criterion type for the page feeds. Ad customizer feeds
+// need no mapping, their attributes are referenced by name in the ads.
+//
+// Status: ENABLED, REMOVED
+type FeedMapping struct {
+	Id                     int64                   `xml:"feedMappingId,omitempty"`
+	FeedId                 int64                   `xml:"feedId"`
+	PlaceholderType        int64                   `xml:"placeholderType,omitempty"`
+	Status                 string                  `xml:"status,omitempty"`
+	AttributeFieldMappings []AttributeFieldMapping `xml:"attributeFieldMappings"`
+	CriterionType          int64                   `xml:"criterionType,omitempty"`
+}
+
+type AttributeFieldMapping struct {
+	FeedAttributeId int64 `xml:"feedAttributeId"`
+	FieldId         int64 `xml:"fieldId"`
+}
+
+// FeedAttributeId returns the id of the feed attribute mapped to the
+// placeholder field.
+func (m FeedMapping) FeedAttributeId(fieldId int64) (int64, error) {
+	for _, fieldMapping := range m.AttributeFieldMappings {
+		if fieldMapping.FieldId == fieldId {
+			return fieldMapping.FeedAttributeId, nil
+		}
+	}
+	return 0, fmt.Errorf("no attribute of feed %d is mapped to the field %d", m.FeedId, fieldId)
+}
+
+// AttributeValue returns the attribute value of a feed item of the feed
+// setting the placeholder field to value, which is a string, an int64, a
+// float64, a bool or a slice of one of them.
+//
+// Example
+//
+//   text, err := sitelinkMapping.AttributeValue(gads.SitelinkFieldLinkText, "Store hours")
+//   urls, err := sitelinkMapping.AttributeValue(gads.SitelinkFieldFinalUrls, []string{"http://example.com/hours"})
+//
+func (m FeedMapping) AttributeValue(fieldId int64, value interface{}) (attributeValue FeedItemAttributeValue, err error) {
+	attributeValue.AttributeID, err = m.FeedAttributeId(fieldId)
+	if err != nil {
+		return attributeValue, err
+	}
+	switch v := value.(type) {
+	case string:
+		attributeValue.StringValue = &v
+	case int64:
+		attributeValue.IntegerValue = &v
+	case float64:
+		attributeValue.DoubleValue = &v
+	case bool:
+		attributeValue.BooleanValue = &v
+	case []string:
+		attributeValue.StringValues = &v
+	case []int64:
+		attributeValue.IntegerValues = &v
+	case []float64:
+		attributeValue.DoubleValues = &v
+	case []bool:
+		attributeValue.BooleanValues = &v
+	default:
+		return attributeValue, fmt.Errorf("unsupported feed attribute value %#v", value)
+	}
+	return attributeValue, nil
+}
+
+// FeedMappingOperations is a map of operations to perform on FeedMapping's,
+// feed mappings can only be added and removed.
+type FeedMappingOperations map[string][]FeedMapping
+
+// FeedMappingFields lists the fields of the feed mappings the
+// FeedMappingService selects and filters on, see FieldCatalog.
+var FeedMappingFields = FieldCatalog{
+	Service: "FeedMappingService",
+	Selectable: []string{
+		"FeedMappingId", "FeedId", "PlaceholderType", "Status", "AttributeFieldMappings",
+		"CriterionType",
+	},
+	Filterable: []string{
+		"FeedMappingId", "FeedId", "PlaceholderType", "Status", "CriterionType",
+	},
+}
+
+// Get returns an array of FeedMapping's and the total number of
+// FeedMapping's matching the selector.
+//
+// Example
+//
+//   feedMappings, totalCount, err := feedMappingService.Get(
+//     gads.Selector{
+//       Fields: []string{"FeedMappingId", "FeedId", "PlaceholderType", "AttributeFieldMappings"},
+//       Predicates: []gads.Predicate{
+//         {"FeedId", "EQUALS", []string{feedId}},
+//         {"Status", "EQUALS", []string{"ENABLED"}},
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/FeedMappingService#get
+//
+func (s *FeedMappingService) Get(selector Selector) (feedMappings []FeedMapping, totalCount int64, err error) {
+	if err := FeedMappingFields.Validate(selector); err != nil {
+		return feedMappings, totalCount, err
+	}
+	selector.XMLName = xml.Name{"", "selector"}
+	respBody, err := s.Auth.request(
+		feedMappingServiceUrl,
+		"get",
+		struct {
+			XMLName xml.Name
+			Sel     Selector
+		}{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(feedMappingServiceUrl),
+				Local: "get",
+			},
+			Sel: selector,
+		},
+	)
+	if err != nil {
+		return feedMappings, totalCount, err
+	}
+	getResp := struct {
+		Size         int64         `xml:"rval>totalNumEntries"`
+		FeedMappings []FeedMapping `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return feedMappings, totalCount, err
+	}
+	return getResp.FeedMappings, getResp.Size, err
+}
+
+// All returns a Pager over all the feed mappings matching selector.
+func (s *FeedMappingService) All(selector Selector) *Pager[FeedMapping] {
+	return NewPager(s.Get, selector)
+}
+
+// Mutate allows you to add and remove feed mappings, returning the
+// modified feed mappings.
+//
+// Example
+//
+//   feedMappings, err := feedMappingService.Mutate(
+//     gads.FeedMappingOperations{
+//       "ADD": {
+//         gads.FeedMapping{
+//           FeedId:          feed.Id,
+//           PlaceholderType: gads.PlaceholderTypeSitelink,
+//           AttributeFieldMappings: []gads.AttributeFieldMapping{
+//             {FeedAttributeId: feed.Attributes[0].Id, FieldId: gads.SitelinkFieldLinkText},
+//             {FeedAttributeId: feed.Attributes[1].Id, FieldId: gads.SitelinkFieldFinalUrls},
+//           },
+//         },
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/FeedMappingService#mutate
+//
+func (s *FeedMappingService) Mutate(feedMappingOperations FeedMappingOperations) (feedMappings []FeedMapping, err error) {
+	type feedMappingOperation struct {
+		Action      string      `xml:"operator"`
+		FeedMapping FeedMapping `xml:"operand"`
+	}
+	operations := []feedMappingOperation{}
+	for action, feedMappings := range feedMappingOperations {
+		for _, feedMapping := range feedMappings {
+			operations = append(operations,
+				feedMappingOperation{
+					Action:      action,
+					FeedMapping: feedMapping,
+				},
+			)
+		}
+	}
+	mutation := struct {
+		XMLName xml.Name
+		Ops     []feedMappingOperation `xml:"operations"`
+	}{
+		XMLName: xml.Name{
+			Space: s.Auth.namespace(feedMappingServiceUrl),
+			Local: "mutate",
+		},
+		Ops: operations,
+	}
+	respBody, err := s.Auth.request(feedMappingServiceUrl, "mutate", mutation)
+	if err != nil {
+		return feedMappings, err
+	}
+	mutateResp := struct {
+		BaseResponse
+		FeedMappings []FeedMapping `xml:"rval>value"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &mutateResp)
+	if err != nil {
+		return feedMappings, err
+	}
+
+	if len(mutateResp.PartialFailureErrors) > 0 {
+		err = mutateResp.PartialFailureErrors
+	}
+
+	return mutateResp.FeedMappings, err
+}
+
+// Query returns the feed mappings matching the awql query, and their total
+// count regardless of the LIMIT clause.
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/FeedMappingService#query
+//
+func (s *FeedMappingService) Query(query string) (feedMappings []FeedMapping, totalCount int64, err error) {
+	respBody, err := s.Auth.request(
+		feedMappingServiceUrl,
+		"query",
+		AWQLQuery{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(feedMappingServiceUrl),
+				Local: "query",
+			},
+			Query: query,
+		},
+	)
+	if err != nil {
+		return feedMappings, totalCount, err
+	}
+	queryResp := struct {
+		Size         int64         `xml:"rval>totalNumEntries"`
+		FeedMappings []FeedMapping `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &queryResp)
+	if err != nil {
+		return feedMappings, totalCount, err
+	}
+	return queryResp.FeedMappings, queryResp.Size, err
+}
@@ -0,0 +1,141 @@
+package gads
+
+import (
+	"strings"
+	"testing"
+)
+
+var testFeedValues = map[string]string{
+	"FeedService": `
+        <value>
+          <id>31</id>
+          <name>sitelinks</name>
+          <attributes><id>3</id><name>Text</name><type>STRING</type><isPartOfKey>false</isPartOfKey></attributes>
+          <attributes><id>4</id><name>Final urls</name><type>URL_LIST</type><isPartOfKey>false</isPartOfKey></attributes>
+          <status>ENABLED</status>
+          <origin>USER</origin>
+        </value>`,
+	"FeedMappingService": `
+        <value>
+          <feedMappingId>41</feedMappingId>
+          <feedId>31</feedId>
+          <placeholderType>1</placeholderType>
+          <status>ENABLED</status>
+          <attributeFieldMappings><feedAttributeId>3</feedAttributeId><fieldId>1</fieldId></attributeFieldMappings>
+          <attributeFieldMappings><feedAttributeId>4</feedAttributeId><fieldId>5</fieldId></attributeFieldMappings>
+        </value>`,
+	"FeedItemService": `
+        <value>
+          <feedId>31</feedId>
+          <feedItemId>51</feedItemId>
+          <status>ENABLED</status>
+          <attributeValues><feedAttributeId>3</feedAttributeId><stringValue>Store hours</stringValue></attributeValues>
+          <devicePreference><devicePreference>30001</devicePreference></devicePreference>
+        </value>`,
+	"FeedItemTargetService": `
+        <value xsi:type="FeedItemAdGroupTarget">
+          <feedId>31</feedId><feedItemId>51</feedItemId><targetType>ADGROUP</targetType><status>ACTIVE</status><adGroupId>61</adGroupId>
+        </value>
+        <value xsi:type="FeedItemCampaignTarget">
+          <feedId>31</feedId><feedItemId>52</feedItemId><targetType>CAMPAIGN</targetType><status>ACTIVE</status><campaignId>71</campaignId>
+        </value>`,
+}
+
+func TestFeed(t *testing.T) {
+	var requests []testRequest
+	auth, cleanup := testServiceAuth(t, &requests, func(request testRequest) string {
+		return testFeedValues[request.Service]
+	})
+	defer cleanup()
+
+	feeds, err := NewFeedService(&auth).Mutate(FeedOperations{
+		"ADD": {{
+			Name: "sitelinks",
+			Attributes: []FeedAttribute{
+				{Name: "Text", AttributeType: "STRING"},
+				{Name: "Final urls", AttributeType: "URL_LIST"},
+			},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(feeds) != 1 || len(feeds[0].Attributes) != 2 || feeds[0].Attributes[1].AttributeType != "URL_LIST" {
+		t.Fatalf("unexpected feeds %#v", feeds)
+	}
+	if body := requests[0].Body; !strings.Contains(body, "<type>URL_LIST</type>") || strings.Contains(body, "systemFeedGenerationData") {
+		t.Errorf("unexpected request\n%s", body)
+	}
+
+	feedMappings, err := NewFeedMappingService(&auth).Mutate(FeedMappingOperations{
+		"ADD": {{
+			FeedId:          feeds[0].Id,
+			PlaceholderType: PlaceholderTypeSitelink,
+			AttributeFieldMappings: []AttributeFieldMapping{
+				{FeedAttributeId: feeds[0].Attributes[0].Id, FieldId: SitelinkFieldLinkText},
+				{FeedAttributeId: feeds[0].Attributes[1].Id, FieldId: SitelinkFieldFinalUrls},
+			},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(feedMappings) != 1 || feedMappings[0].Id != 41 {
+		t.Fatalf("unexpected feed mappings %#v", feedMappings)
+	}
+
+	// the attribute ids come from the mapping, not from the field ids
+	text, err := feedMappings[0].AttributeValue(SitelinkFieldLinkText, "Store hours")
+	if err != nil || text.AttributeID != 3 || *text.StringValue != "Store hours" {
+		t.Errorf("unexpected attribute value %#v, %v", text, err)
+	}
+	urls, err := feedMappings[0].AttributeValue(SitelinkFieldFinalUrls, []string{"http://example.com/hours"})
+	if err != nil || urls.AttributeID != 4 || len(*urls.StringValues) != 1 {
+		t.Errorf("unexpected attribute value %#v, %v", urls, err)
+	}
+	if _, err := feedMappings[0].AttributeValue(SitelinkFieldTrackingUrl, "http://example.com"); err == nil {
+		t.Error("expected an unmapped field to be rejected")
+	}
+	if _, err := feedMappings[0].AttributeValue(SitelinkFieldLinkText, 1); err == nil {
+		t.Error("expected an unsupported value to be rejected")
+	}
+
+	mobile := int64(30001)
+	feedItems, err := NewFeedItemService(&auth).Mutate(FeedItemOperations{
+		"ADD": {{FeedID: feeds[0].Id, AttributeValues: []FeedItemAttributeValue{text, urls}, DevicePreference: &mobile}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(feedItems) != 1 || feedItems[0].DevicePreference == nil || *feedItems[0].DevicePreference != mobile {
+		t.Errorf("unexpected feed items %#v", feedItems)
+	}
+	body := requests[2].Body
+	if !strings.Contains(body, "<feedAttributeId>4</feedAttributeId>") || !strings.Contains(body, "<devicePreference>30001</devicePreference>") ||
+		strings.Contains(body, "geoTargeting") {
+		t.Errorf("unexpected request\n%s", body)
+	}
+
+	targets, err := NewFeedItemTargetService(&auth).Mutate(FeedItemTargetOperations{
+		"ADD": {
+			FeedItemAdGroupTarget{FeedID: 31, FeedItemID: 51, AdGroupId: 61},
+			FeedItemCampaignTarget{FeedID: 31, FeedItemID: 52, CampaignId: 71},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(targets) != 2 {
+		t.Fatalf("unexpected targets %#v", targets)
+	}
+	if target, ok := targets[0].(FeedItemAdGroupTarget); !ok || target.AdGroupId != 61 || target.Status != "ACTIVE" {
+		t.Errorf("unexpected target %#v", targets[0])
+	}
+	if target, ok := targets[1].(FeedItemCampaignTarget); !ok || target.CampaignId != 71 || target.GetFeedItemID() != 52 {
+		t.Errorf("unexpected target %#v", targets[1])
+	}
+	body = requests[3].Body
+	if !strings.Contains(body, `xsi:type="FeedItemAdGroupTarget"`) || !strings.Contains(body, `xsi:type="FeedItemCampaignTarget"`) {
+		t.Errorf("unexpected request\n%s", body)
+	}
+}
@@ -0,0 +1,144 @@
+package gads
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// Function is an expression applying an operator to operands, used as the
+// matching function of the campaign, ad group and customer feeds to select
+// the feed items served.
+//
+// Operator: IN, IDENTITY, EQUALS, AND, CONTAINS_ANY
+// FunctionString: the expression as text, returned by the api
+//
+// Example
+//
+//   // serve the feed items 10 and 11 only
+//   gads.Function{
+//     Operator:   "IN",
+//     LhsOperand: gads.FunctionArgumentOperands{gads.RequestContextOperand{ContextType: "FEED_ITEM_ID"}},
+//     RhsOperand: gads.FunctionArgumentOperands{gads.NewLongConstant(10), gads.NewLongConstant(11)},
+//   }
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/guides/feed-matching-functions
+//
+type Function struct {
+	Operator       string                   `xml:"operator"`
+	LhsOperand     FunctionArgumentOperands `xml:"lhsOperand,omitempty"`
+	RhsOperand     FunctionArgumentOperands `xml:"rhsOperand,omitempty"`
+	FunctionString string                   `xml:"functionString,omitempty"`
+}
+
+// FunctionArgumentOperand is an operand of a Function, a
+// FeedAttributeOperand, a ConstantOperand, a FunctionOperand or a
+// RequestContextOperand.
+type FunctionArgumentOperand interface {
+	GetType() string
+}
+
+// FeedAttributeOperand is the value of an attribute of the feed items
+type FeedAttributeOperand struct {
+	Type            string `xml:"xsi:type,attr,omitempty"`
+	FeedId          int64  `xml:"feedId"`
+	FeedAttributeId int64  `xml:"feedAttributeId"`
+}
+
+func (o FeedAttributeOperand) GetType() string {
+	return "FeedAttributeOperand"
+}
+
+// ConstantType: DOUBLE, STRING, BOOLEAN, LONG
+// Unit: METERS, MILES, MINOR_CURRENCY, NONE
+type ConstantOperand struct {
+	Type         string   `xml:"xsi:type,attr,omitempty"`
+	ConstantType string   `xml:"type"`
+	Unit         string   `xml:"unit,omitempty"`
+	LongValue    *int64   `xml:"longValue,omitempty"`
+	BooleanValue *bool    `xml:"booleanValue,omitempty"`
+	DoubleValue  *float64 `xml:"doubleValue,omitempty"`
+	StringValue  *string  `xml:"stringValue,omitempty"`
+}
+
+func (o ConstantOperand) GetType() string {
+	return "ConstantOperand"
+}
+
+func NewLongConstant(value int64) ConstantOperand {
+	return ConstantOperand{ConstantType: "LONG", LongValue: &value}
+}
+
+func NewBooleanConstant(value bool) ConstantOperand {
+	return ConstantOperand{ConstantType: "BOOLEAN", BooleanValue: &value}
+}
+
+func NewDoubleConstant(value float64) ConstantOperand {
+	return ConstantOperand{ConstantType: "DOUBLE", DoubleValue: &value}
+}
+
+func NewStringConstant(value string) ConstantOperand {
+	return ConstantOperand{ConstantType: "STRING", StringValue: &value}
+}
+
+// FunctionOperand nests a Function in another one
+type FunctionOperand struct {
+	Type  string   `xml:"xsi:type,attr,omitempty"`
+	Value Function `xml:"value"`
+}
+
+func (o FunctionOperand) GetType() string {
+	return "FunctionOperand"
+}
+
+// ContextType: FEED_ITEM_ID, DEVICE_PLATFORM, FEED_ITEM_ID_LIST
+type RequestContextOperand struct {
+	Type        string `xml:"xsi:type,attr,omitempty"`
+	ContextType string `xml:"contextType"`
+}
+
+func (o RequestContextOperand) GetType() string {
+	return "RequestContextOperand"
+}
+
+type FunctionArgumentOperands []FunctionArgumentOperand
+
+func (operands *FunctionArgumentOperands) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	operandType, err := findAttr(start.Attr, xml.Name{Space: "http://www.w3.org/2001/XMLSchema-instance", Local: "type"})
+	if err != nil {
+		return err
+	}
+	switch operandType {
+	case "FeedAttributeOperand":
+		o := FeedAttributeOperand{Type: operandType}
+		if err := dec.DecodeElement(&o, &start); err != nil {
+			return err
+		}
+		*operands = append(*operands, o)
+	case "ConstantOperand":
+		o := ConstantOperand{Type: operandType}
+		if err := dec.DecodeElement(&o, &start); err != nil {
+			return err
+		}
+		*operands = append(*operands, o)
+	case "FunctionOperand":
+		o := FunctionOperand{Type: operandType}
+		if err := dec.DecodeElement(&o, &start); err != nil {
+			return err
+		}
+		*operands = append(*operands, o)
+	case "RequestContextOperand":
+		o := RequestContextOperand{Type: operandType}
+		if err := dec.DecodeElement(&o, &start); err != nil {
+			return err
+		}
+		*operands = append(*operands, o)
+	default:
+		if StrictMode {
+			return fmt.Errorf("unknown function operand type %#v", operandType)
+		}
+		return dec.Skip()
+	}
+	return nil
+}
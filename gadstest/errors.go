@@ -0,0 +1,94 @@
+package gadstest
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ApiError is an error reported by the fake server, in an ApiExceptionFault
+// or as a partial failure. Type is the xsi type of the error and Reason its
+// reason, as "EntityNotFound" and "INVALID_ID".
+type ApiError struct {
+	Type      string
+	Reason    string
+	FieldPath string // as "operations[1].operand.name"
+	Trigger   string
+
+	// RetryAfterSeconds is only sent with a RateExceededError
+	RetryAfterSeconds int
+}
+
+// RateExceeded returns the error of a request over the account rate limit.
+func RateExceeded(retryAfterSeconds int) ApiError {
+	return ApiError{Type: "RateExceededError", Reason: "RATE_EXCEEDED", RetryAfterSeconds: retryAfterSeconds}
+}
+
+// InternalError returns the error of a request failing on the api side.
+func InternalError() ApiError {
+	return ApiError{Type: "InternalApiError", Reason: "UNEXPECTED_INTERNAL_API_ERROR"}
+}
+
+func (e ApiError) String() string {
+	s := e.Type + "." + e.Reason
+	if e.FieldPath != "" {
+		s += " @ " + e.FieldPath
+	}
+	if e.Trigger != "" {
+		s += "; trigger:'" + e.Trigger + "'"
+	}
+	return s
+}
+
+// node returns the error as sent by the api, named name
+func (e ApiError) node(name string) *node {
+	n := element(name,
+		leaf("fieldPath", e.FieldPath),
+		leaf("trigger", e.Trigger),
+		leaf("errorString", e.Type+"."+e.Reason),
+		leaf("reason", e.Reason),
+	).setXSIType(e.Type)
+	if e.Type == "RateExceededError" {
+		n.Children = append(n.Children,
+			leaf("rateName", "RATE_LIMIT"),
+			leaf("rateScope", "ACCOUNT"),
+			leaf("retryAfterSeconds", strconv.Itoa(e.RetryAfterSeconds)),
+		)
+	}
+	return n
+}
+
+// operationError returns an error on the operation at index i, field being
+// the path of the faulty field in the operation.
+func operationError(i int, field, errorType, reason, trigger string) ApiError {
+	return ApiError{
+		Type:      errorType,
+		Reason:    reason,
+		FieldPath: fmt.Sprintf("operations[%d].%s", i, strings.Replace(field, "/", ".", -1)),
+		Trigger:   trigger,
+	}
+}
+
+// fault returns the soap fault reporting errs
+func fault(namespace string, errs []ApiError) *node {
+	messages := []string{}
+	for _, e := range errs {
+		messages = append(messages, e.String())
+	}
+	message := "[" + strings.Join(messages, ", ") + "]"
+	apiException := element("ApiExceptionFault",
+		leaf("message", message),
+		leaf("ApplicationException.Type", "ApiException"),
+	)
+	apiException.XMLName.Space = namespace
+	for _, e := range errs {
+		apiException.Children = append(apiException.Children, e.node("errors"))
+	}
+	f := element("Fault",
+		leaf("faultcode", "soap:Server"),
+		leaf("faultstring", message),
+		element("detail", apiException),
+	)
+	f.XMLName.Space = soapNamespace
+	return f
+}
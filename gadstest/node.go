@@ -0,0 +1,190 @@
+package gadstest
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// node is a generic xml element. The entities are stored as they were sent
+// by the client, so the server doesn't depend on the gads structs.
+type node struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Children []*node    `xml:",any"`
+	Text     string     `xml:",chardata"`
+}
+
+// element returns a new node named name holding children
+func element(name string, children ...*node) *node {
+	return &node{XMLName: xml.Name{Local: name}, Children: children}
+}
+
+// leaf returns a new node named name holding text
+func leaf(name, text string) *node {
+	return &node{XMLName: xml.Name{Local: name}, Text: text}
+}
+
+// normalize strips the namespaces and the indentation parsed from a
+// request, the elements inherit the namespace of the response they are
+// written in.
+func (n *node) normalize() *node {
+	n.XMLName.Space = ""
+	attrs := n.Attrs[:0]
+	for _, attr := range n.Attrs {
+		if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" {
+			continue
+		}
+		attrs = append(attrs, attr)
+	}
+	n.Attrs = attrs
+	if len(n.Children) > 0 {
+		n.Text = ""
+	}
+	for _, child := range n.Children {
+		child.normalize()
+	}
+	return n
+}
+
+func (n *node) clone() *node {
+	c := &node{XMLName: n.XMLName, Text: n.Text}
+	c.Attrs = append(c.Attrs, n.Attrs...)
+	for _, child := range n.Children {
+		c.Children = append(c.Children, child.clone())
+	}
+	return c
+}
+
+// xsiType returns the xsi:type attribute of the node if any
+func (n *node) xsiType() string {
+	for _, attr := range n.Attrs {
+		if attr.Name.Space == xsiNamespace && attr.Name.Local == "type" {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+func (n *node) setXSIType(xsiType string) *node {
+	n.Attrs = append(n.Attrs, xml.Attr{Name: xml.Name{Space: xsiNamespace, Local: "type"}, Value: xsiType})
+	return n
+}
+
+// children returns the direct children named name
+func (n *node) children(name string) (children []*node) {
+	for _, child := range n.Children {
+		if child.XMLName.Local == name {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// find returns the nodes matching the slash separated path, as
+// "criterion/id"
+func (n *node) find(path string) []*node {
+	nodes := []*node{n}
+	for _, name := range strings.Split(path, "/") {
+		var next []*node
+		for _, n := range nodes {
+			next = append(next, n.children(name)...)
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+// values returns the text of the nodes matching path
+func (n *node) values(path string) (values []string) {
+	for _, n := range n.find(path) {
+		values = append(values, strings.TrimSpace(n.Text))
+	}
+	return values
+}
+
+// value returns the text of the first node matching path, "" if none
+func (n *node) value(path string) string {
+	if values := n.values(path); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// set sets the text of the node at path, creating the missing elements
+func (n *node) set(path, value string) {
+	for _, name := range strings.Split(path, "/") {
+		children := n.children(name)
+		if len(children) == 0 {
+			children = []*node{element(name)}
+			n.Children = append(n.Children, children[0])
+		}
+		n = children[0]
+	}
+	n.Text = value
+}
+
+// remove removes the direct children named name
+func (n *node) remove(name string) {
+	children := n.Children[:0]
+	for _, child := range n.Children {
+		if child.XMLName.Local != name {
+			children = append(children, child)
+		}
+	}
+	n.Children = children
+}
+
+// merge applies the fields set in update, the way a SET operation does.
+// Elements left empty are not modified, single elements are merged
+// recursively and repeated ones are replaced.
+func (n *node) merge(update *node) {
+	done := map[string]bool{}
+	for _, child := range update.Children {
+		name := child.XMLName.Local
+		if done[name] {
+			continue
+		}
+		done[name] = true
+		updates, current := update.children(name), n.children(name)
+		if len(updates) == 1 && len(updates[0].Children) == 0 && strings.TrimSpace(updates[0].Text) == "" {
+			continue
+		}
+		if len(updates) == 1 && len(current) == 1 && len(updates[0].Children) > 0 {
+			current[0].merge(updates[0])
+			continue
+		}
+		n.remove(name)
+		for _, u := range updates {
+			n.Children = append(n.Children, u.clone())
+		}
+	}
+}
+
+// project returns a copy of the node restricted to the given paths, the
+// way the api only returns the selected fields.
+func (n *node) project(paths [][]string) *node {
+	c := &node{XMLName: n.XMLName, Attrs: n.Attrs, Text: n.Text}
+	for _, child := range n.Children {
+		keep := false
+		var sub [][]string
+		for _, path := range paths {
+			if path[0] != child.XMLName.Local {
+				continue
+			}
+			if len(path) == 1 {
+				keep = true
+				break
+			}
+			sub = append(sub, path[1:])
+		}
+		switch {
+		case keep:
+			c.Children = append(c.Children, child.clone())
+		case len(sub) > 0:
+			c.Children = append(c.Children, child.project(sub))
+		}
+	}
+	return c
+}
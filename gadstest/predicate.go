@@ -0,0 +1,109 @@
+package gadstest
+
+import (
+	"strconv"
+	"strings"
+)
+
+// predicate is a selector predicate on the values at path
+type predicate struct {
+	path     string
+	operator string
+	values   []string
+}
+
+func (p predicate) match(n *node) bool {
+	return operators[p.operator](n.values(p.path), p.values)
+}
+
+// operators evaluate a predicate on the values of an entity
+var operators = map[string]func(values, predicate []string) bool{
+	"EQUALS":     func(v, p []string) bool { return anyMatch(v, p[0], equals) },
+	"NOT_EQUALS": func(v, p []string) bool { return !anyMatch(v, p[0], equals) },
+	"IN":         in,
+	"NOT_IN":     func(v, p []string) bool { return !in(v, p) },
+	"GREATER_THAN": func(v, p []string) bool {
+		return anyMatch(v, p[0], func(a, b string) bool { return compare(a, b) > 0 })
+	},
+	"GREATER_THAN_EQUALS": func(v, p []string) bool {
+		return anyMatch(v, p[0], func(a, b string) bool { return compare(a, b) >= 0 })
+	},
+	"LESS_THAN": func(v, p []string) bool {
+		return anyMatch(v, p[0], func(a, b string) bool { return compare(a, b) < 0 })
+	},
+	"LESS_THAN_EQUALS": func(v, p []string) bool {
+		return anyMatch(v, p[0], func(a, b string) bool { return compare(a, b) <= 0 })
+	},
+	"STARTS_WITH":             func(v, p []string) bool { return anyMatch(v, p[0], strings.HasPrefix) },
+	"STARTS_WITH_IGNORE_CASE": func(v, p []string) bool { return anyMatch(v, p[0], ignoreCase(strings.HasPrefix)) },
+	"CONTAINS":                func(v, p []string) bool { return anyMatch(v, p[0], strings.Contains) },
+	"CONTAINS_IGNORE_CASE":    func(v, p []string) bool { return anyMatch(v, p[0], ignoreCase(strings.Contains)) },
+	"DOES_NOT_CONTAIN":        func(v, p []string) bool { return !anyMatch(v, p[0], strings.Contains) },
+	"DOES_NOT_CONTAIN_IGNORE_CASE": func(v, p []string) bool {
+		return !anyMatch(v, p[0], ignoreCase(strings.Contains))
+	},
+	"CONTAINS_ANY":  in,
+	"CONTAINS_NONE": func(v, p []string) bool { return !in(v, p) },
+	"CONTAINS_ALL": func(v, p []string) bool {
+		for _, value := range p {
+			if !anyMatch(v, value, equals) {
+				return false
+			}
+		}
+		return true
+	},
+}
+
+// multipleValues are the operators accepting several values
+var multipleValues = map[string]bool{
+	"IN":            true,
+	"NOT_IN":        true,
+	"CONTAINS_ANY":  true,
+	"CONTAINS_NONE": true,
+	"CONTAINS_ALL":  true,
+}
+
+// in tells if one of the values is one of the predicate values
+func in(values, predicate []string) bool {
+	for _, p := range predicate {
+		if anyMatch(values, p, equals) {
+			return true
+		}
+	}
+	return false
+}
+
+// anyMatch tells if one of the values matches the predicate value
+func anyMatch(values []string, predicate string, match func(value, predicate string) bool) bool {
+	for _, value := range values {
+		if match(value, predicate) {
+			return true
+		}
+	}
+	return false
+}
+
+func equals(a, b string) bool {
+	return compare(a, b) == 0
+}
+
+func ignoreCase(match func(string, string) bool) func(string, string) bool {
+	return func(value, predicate string) bool {
+		return match(strings.ToLower(value), strings.ToLower(predicate))
+	}
+}
+
+// compare compares two values, numerically if both are numbers
+func compare(a, b string) int {
+	x, errA := strconv.ParseFloat(a, 64)
+	y, errB := strconv.ParseFloat(b, 64)
+	switch {
+	case errA != nil || errB != nil:
+		return strings.Compare(a, b)
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,533 @@
+// Package gadstest provides an in-process fake of the AdWords soap api, to
+// test the code using gads without credentials nor network access.
+//
+// The fake keeps budgets, campaigns, ad groups, ad group and campaign
+// criteria and labels in memory. It honors the fields, predicates, ordering
+// and paging of the selectors, the partialFailure and validateOnly
+// headers, and reports the errors the api would, as faults or partial
+// failures.
+//
+// Example
+//
+//   server := gadstest.NewServer()
+//   defer server.Close()
+//
+//   campaignService := gads.NewCampaignService(server.Auth())
+//
+package gadstest
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/querian/gads"
+)
+
+const (
+	soapNamespace = "http://schemas.xmlsoap.org/soap/envelope/"
+	namespaceRoot = "https://adwords.google.com/api/adwords/"
+)
+
+// entity is an entity kept by the server, as sent by the client
+type entity struct {
+	node   *node
+	labels []int64
+}
+
+// Server is a fake AdWords api served over http. It is safe for concurrent
+// use.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	lastID   int64
+	entities map[string][]*entity // by kind name
+	failures [][]ApiError
+}
+
+// NewServer starts and returns a new empty Server. The caller should call
+// Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{lastID: 1000000, entities: map[string][]*entity{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// NewService starts a Server closed at the end of the test and returns the
+// service built by newService on it.
+//
+//   campaignService, server := gadstest.NewService(t, gads.NewCampaignService)
+//
+func NewService[S any](t testing.TB, newService func(*gads.Auth) S) (S, *Server) {
+	s := NewServer()
+	t.Cleanup(s.Close)
+	return newService(s.Auth()), s
+}
+
+// Auth returns an Auth sending its requests to the server.
+func (s *Server) Auth() *gads.Auth {
+	return &gads.Auth{
+		CustomerId:     "123-456-7890",
+		DeveloperToken: "gadstest",
+		UserAgent:      "gadstest",
+		Client:         s.Client(),
+		Endpoint:       s.URL,
+	}
+}
+
+// FailNext makes the next request fail with a fault reporting errs, as
+//
+//   server.FailNext(gadstest.RateExceeded(30))
+//
+// Successive calls fail as many requests.
+func (s *Server) FailNext(errs ...ApiError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, errs)
+}
+
+// request is a soap request received by the server
+type request struct {
+	Header struct {
+		DeveloperToken   string `xml:"RequestHeader>developerToken"`
+		ClientCustomerId string `xml:"RequestHeader>clientCustomerId"`
+		PartialFailure   bool   `xml:"RequestHeader>partialFailure"`
+		ValidateOnly     bool   `xml:"RequestHeader>validateOnly"`
+	} `xml:"Header"`
+	Body struct {
+		Method node `xml:",any"`
+	} `xml:"Body"`
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	// the path ends with /cm/v201806/CampaignService
+	path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(path) < 3 {
+		http.NotFound(w, r)
+		return
+	}
+	group, version, serviceName := path[len(path)-3], path[len(path)-2], path[len(path)-1]
+	namespace := namespaceRoot + group + "/" + version
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req := request{}
+	if err := xml.Unmarshal(body, &req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	method := req.Body.Method.normalize()
+
+	s.mu.Lock()
+	response, operations, errs := s.handle(req, serviceName, method)
+	s.mu.Unlock()
+
+	status := http.StatusOK
+	if len(errs) > 0 {
+		status = http.StatusInternalServerError
+		response = fault(namespace, errs)
+	} else {
+		response.XMLName.Space = namespace
+	}
+	header := element("ResponseHeader",
+		leaf("requestId", strconv.FormatInt(start.UnixNano(), 16)),
+		leaf("serviceName", serviceName),
+		leaf("methodName", method.XMLName.Local),
+		leaf("operations", strconv.Itoa(operations)),
+		leaf("responseTime", strconv.FormatInt(int64(time.Since(start)/time.Millisecond), 10)),
+	)
+	header.XMLName.Space = namespace
+	envelope := element("Envelope", element("Header", header), element("Body", response))
+	envelope.XMLName.Space = soapNamespace
+	envelope.Children[0].XMLName.Space = soapNamespace
+	envelope.Children[1].XMLName.Space = soapNamespace
+
+	w.Header().Set("Content-Type", "text/xml; charset=UTF-8")
+	w.WriteHeader(status)
+	fmt.Fprint(w, xml.Header)
+	xml.NewEncoder(w).Encode(envelope)
+}
+
+// handle returns the response to the method of the service, the number of
+// operations it counts for and the errors to report in a fault.
+func (s *Server) handle(req request, serviceName string, method *node) (*node, int, []ApiError) {
+	if len(s.failures) > 0 {
+		errs := s.failures[0]
+		s.failures = s.failures[1:]
+		return nil, 0, errs
+	}
+	if req.Header.DeveloperToken == "" {
+		return nil, 0, []ApiError{{Type: "QuotaCheckError", Reason: "INVALID_TOKEN_HEADER"}}
+	}
+	if req.Header.ClientCustomerId == "" {
+		return nil, 0, []ApiError{{Type: "AuthenticationError", Reason: "CLIENT_CUSTOMER_ID_IS_REQUIRED"}}
+	}
+	k, ok := services[serviceName]
+	if ok && len(method.Children) > 0 {
+		switch method.XMLName.Local {
+		case "get":
+			return s.get(k, method.Children[0])
+		case "mutate":
+			return s.mutate(k, method, req.Header.PartialFailure, req.Header.ValidateOnly)
+		case "mutateLabel":
+			if k.labelID != "" {
+				return s.mutateLabel(k, method, req.Header.PartialFailure, req.Header.ValidateOnly)
+			}
+		}
+	}
+	return nil, 0, []ApiError{{
+		Type:    "RequestError",
+		Reason:  "INVALID_INPUT",
+		Trigger: serviceName + "." + method.XMLName.Local + " is not supported by gadstest",
+	}}
+}
+
+// find returns the entity of the kind named kindName with the given id,
+// parentID restricting the search to the children of a parent if set.
+func (s *Server) find(kindName, id, parentID string) *entity {
+	k := kindByName(kindName)
+	for _, e := range s.entities[kindName] {
+		if e.node.value(k.idPath) == id && (parentID == "" || e.node.value(k.parentID) == parentID) {
+			return e
+		}
+	}
+	return nil
+}
+
+func kindByName(name string) *kind {
+	for _, k := range services {
+		if k.name == name {
+			return k
+		}
+	}
+	return nil
+}
+
+// render returns a copy of the entity, with the fields computed by the api
+func (s *Server) render(k *kind, e *entity) *node {
+	n := e.node.clone()
+	if k.render != nil {
+		k.render(s, n)
+	}
+	for _, labelID := range e.labels {
+		if label := s.find("Label", strconv.FormatInt(labelID, 10), ""); label != nil {
+			l := label.node.clone()
+			l.XMLName.Local = "labels"
+			n.Children = append(n.Children, l)
+		}
+	}
+	return n
+}
+
+// get returns the page of entities matching the selector
+func (s *Server) get(k *kind, selector *node) (*node, int, []ApiError) {
+	var errs []ApiError
+	selectorError := func(path, reason, trigger string) {
+		errs = append(errs, ApiError{
+			Type:      "SelectorError",
+			Reason:    reason,
+			FieldPath: "serviceSelector." + path,
+			Trigger:   trigger,
+		})
+	}
+
+	var paths [][]string
+	for i, name := range selector.values("fields") {
+		f, ok := k.fields[name]
+		if !ok {
+			selectorError(fmt.Sprintf("fields[%d]", i), "INVALID_FIELD_NAME", name)
+			continue
+		}
+		paths = append(paths, strings.Split(f.path, "/"))
+	}
+	if len(selector.children("fields")) == 0 {
+		selectorError("fields", "MISSING_FIELDS", "")
+	}
+
+	var predicates []predicate
+	for i, p := range selector.children("predicates") {
+		pred := predicate{operator: p.value("operator"), values: p.values("values")}
+		f, ok := k.fields[p.value("field")]
+		pred.path = f.filter
+		switch {
+		case !ok:
+			selectorError(fmt.Sprintf("predicates[%d].field", i), "INVALID_PREDICATE_FIELD_NAME", p.value("field"))
+		case operators[pred.operator] == nil:
+			selectorError(fmt.Sprintf("predicates[%d].operator", i), "INVALID_PREDICATE_OPERATOR", pred.operator)
+		case len(pred.values) == 0:
+			selectorError(fmt.Sprintf("predicates[%d].values", i), "MISSING_PREDICATE_VALUES", "")
+		case len(pred.values) > 1 && !multipleValues[pred.operator]:
+			selectorError(fmt.Sprintf("predicates[%d].values", i), "OPERATOR_DOES_NOT_SUPPORT_MULTIPLE_VALUES", pred.operator)
+		}
+		predicates = append(predicates, pred)
+	}
+
+	type order struct {
+		path       string
+		descending bool
+	}
+	var ordering []order
+	for i, o := range selector.children("ordering") {
+		f, ok := k.fields[o.value("field")]
+		if !ok {
+			selectorError(fmt.Sprintf("ordering[%d].field", i), "INVALID_FIELD_NAME", o.value("field"))
+		}
+		ordering = append(ordering, order{f.filter, o.value("sortOrder") == "DESCENDING"})
+	}
+
+	offset, limit := 0, -1
+	if paging := selector.children("paging"); len(paging) > 0 {
+		offset, _ = strconv.Atoi(paging[0].value("startIndex"))
+		limit, _ = strconv.Atoi(paging[0].value("numberResults"))
+		if offset < 0 {
+			selectorError("paging.startIndex", "INVALID_START_INDEX", strconv.Itoa(offset))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, 0, errs
+	}
+
+	var entries []*node
+	for _, e := range s.entities[k.name] {
+		n := s.render(k, e)
+		matches := true
+		for _, p := range predicates {
+			matches = matches && p.match(n)
+		}
+		if matches {
+			entries = append(entries, n)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		for _, o := range ordering {
+			c := compare(entries[i].value(o.path), entries[j].value(o.path))
+			if c != 0 {
+				return (c < 0) != o.descending
+			}
+		}
+		return false
+	})
+
+	rval := element("rval",
+		leaf("totalNumEntries", strconv.Itoa(len(entries))),
+		leaf("Page.Type", k.name+"Page"),
+	)
+	if offset > len(entries) {
+		offset = len(entries)
+	}
+	entries = entries[offset:]
+	if limit >= 0 && limit < len(entries) {
+		entries = entries[:limit]
+	}
+	for _, n := range entries {
+		entry := n.project(paths)
+		entry.XMLName.Local = "entries"
+		rval.Children = append(rval.Children, entry)
+	}
+	return element("getResponse", rval), len(entries), nil
+}
+
+// snapshot returns a copy of the entities, to restore them when a mutate
+// fails or only validates the operations
+func (s *Server) snapshot() map[string][]*entity {
+	entities := map[string][]*entity{}
+	for name, es := range s.entities {
+		for _, e := range es {
+			entities[name] = append(entities[name], &entity{
+				node:   e.node.clone(),
+				labels: append([]int64(nil), e.labels...),
+			})
+		}
+	}
+	return entities
+}
+
+// operate applies the operations of a mutate with apply, which returns the
+// result of an operation or the errors it failed with. The operations are
+// atomic, unless partialFailure is set.
+func (s *Server) operate(
+	k *kind,
+	method *node,
+	partialFailure, validateOnly bool,
+	apply func(i int, operator string, operand *node) (*node, []ApiError),
+) (*node, int, []ApiError) {
+	snapshot := s.snapshot()
+	operations := method.children("operations")
+	rval := element("rval", leaf("ListReturnValue.Type", k.name+"ReturnValue"))
+	var errs []ApiError
+	for i, op := range operations {
+		operands := op.children("operand")
+		if len(operands) == 0 {
+			errs = append(errs, operationError(i, "operand", "RequiredError", "REQUIRED", ""))
+			rval.Children = append(rval.Children, element("value"))
+			continue
+		}
+		value, opErrs := apply(i, op.value("operator"), operands[0])
+		if len(opErrs) > 0 {
+			errs = append(errs, opErrs...)
+			value = element("value")
+		}
+		rval.Children = append(rval.Children, value)
+	}
+
+	if validateOnly || (len(errs) > 0 && !partialFailure) {
+		s.entities = snapshot
+	}
+	if len(errs) > 0 && !partialFailure {
+		return nil, len(operations), errs
+	}
+	if validateOnly {
+		return element("mutateResponse", element("rval")), len(operations), nil
+	}
+	for _, e := range errs {
+		rval.Children = append(rval.Children, e.node("partialFailureErrors"))
+	}
+	return element("mutateResponse", rval), len(operations), nil
+}
+
+// mutate adds, sets and removes entities
+func (s *Server) mutate(k *kind, method *node, partialFailure, validateOnly bool) (*node, int, []ApiError) {
+	return s.operate(k, method, partialFailure, validateOnly, func(i int, operator string, operand *node) (*node, []ApiError) {
+		if !k.supports(operator) {
+			return nil, []ApiError{operationError(i, "operator", "OperatorError", "OPERATOR_NOT_SUPPORTED", operator)}
+		}
+		var e *entity
+		switch operator {
+		case "ADD":
+			var errs []ApiError
+			for _, path := range k.required {
+				if len(operand.find(path)) == 0 || (operand.value(path) == "" && len(operand.find(path)[0].Children) == 0) {
+					errs = append(errs, operationError(i, "operand/"+path, "RequiredError", "REQUIRED", ""))
+				}
+			}
+			if len(errs) > 0 {
+				return nil, errs
+			}
+			if k.parent != "" {
+				parent := s.find(k.parent, operand.value(k.parentID), "")
+				if parent == nil || parent.node.value("status") == "REMOVED" {
+					return nil, []ApiError{operationError(i, "operand/"+k.parentID, "EntityNotFound", "INVALID_ID", operand.value(k.parentID))}
+				}
+			}
+			if err := s.checkUniqueName(k, operand, nil); err != nil {
+				err.FieldPath = fmt.Sprintf("operations[%d].%s", i, err.FieldPath)
+				return nil, []ApiError{*err}
+			}
+			e = &entity{node: operand.clone()}
+			e.node.XMLName.Local = "value"
+			if e.node.value(k.idPath) == "" {
+				s.lastID++
+				e.node.set(k.idPath, strconv.FormatInt(s.lastID, 10))
+			}
+			for path, value := range k.defaults {
+				if e.node.value(path) == "" {
+					e.node.set(path, value)
+				}
+			}
+			s.entities[k.name] = append(s.entities[k.name], e)
+		case "SET", "REMOVE":
+			parentID := ""
+			if k.removable {
+				parentID = operand.value(k.parentID)
+			}
+			e = s.find(k.name, operand.value(k.idPath), parentID)
+			if e == nil {
+				return nil, []ApiError{operationError(i, "operand/"+k.idPath, "EntityNotFound", "INVALID_ID", operand.value(k.idPath))}
+			}
+			if operator == "REMOVE" && k.removable {
+				s.delete(k, e)
+				break
+			}
+			if operator == "REMOVE" {
+				e.node.set("status", "REMOVED")
+				break
+			}
+			if err := s.checkUniqueName(k, operand, e); err != nil {
+				err.FieldPath = fmt.Sprintf("operations[%d].%s", i, err.FieldPath)
+				return nil, []ApiError{*err}
+			}
+			e.node.merge(operand)
+		}
+		return s.render(k, e), nil
+	})
+}
+
+// checkUniqueName returns the error to report if the name of the operand
+// is used by another entity of the same parent than current.
+func (s *Server) checkUniqueName(k *kind, operand *node, current *entity) *ApiError {
+	name := operand.value(k.uniqueName)
+	if k.uniqueName == "" || name == "" || operand.value("status") == "REMOVED" {
+		return nil
+	}
+	parentID := operand.value(k.parentID)
+	if current != nil {
+		parentID = current.node.value(k.parentID)
+	}
+	for _, e := range s.entities[k.name] {
+		if e != current && e.node.value(k.uniqueName) == name && e.node.value("status") != "REMOVED" &&
+			(k.parent == "" || e.node.value(k.parentID) == parentID) {
+			return &ApiError{
+				Type:      k.duplicateError[0],
+				Reason:    k.duplicateError[1],
+				FieldPath: "operand." + k.uniqueName,
+				Trigger:   name,
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Server) delete(k *kind, removed *entity) {
+	entities := s.entities[k.name][:0]
+	for _, e := range s.entities[k.name] {
+		if e != removed {
+			entities = append(entities, e)
+		}
+	}
+	s.entities[k.name] = entities
+}
+
+// mutateLabel adds and removes labels on entities
+func (s *Server) mutateLabel(k *kind, method *node, partialFailure, validateOnly bool) (*node, int, []ApiError) {
+	labelKind := &kind{name: k.name + "Label"}
+	return s.operate(labelKind, method, partialFailure, validateOnly, func(i int, operator string, operand *node) (*node, []ApiError) {
+		if operator != "ADD" && operator != "REMOVE" {
+			return nil, []ApiError{operationError(i, "operator", "OperatorError", "OPERATOR_NOT_SUPPORTED", operator)}
+		}
+		e := s.find(k.name, operand.value(k.labelID), "")
+		if e == nil {
+			return nil, []ApiError{operationError(i, "operand/"+k.labelID, "EntityNotFound", "INVALID_ID", operand.value(k.labelID))}
+		}
+		labelID, _ := strconv.ParseInt(operand.value("labelId"), 10, 64)
+		label := s.find("Label", operand.value("labelId"), "")
+		if label == nil || label.node.value("status") == "REMOVED" {
+			return nil, []ApiError{operationError(i, "operand/labelId", "EntityNotFound", "INVALID_ID", operand.value("labelId"))}
+		}
+		labels := e.labels[:0]
+		for _, id := range e.labels {
+			if id != labelID {
+				labels = append(labels, id)
+			}
+		}
+		e.labels = labels
+		if operator == "ADD" {
+			e.labels = append(e.labels, labelID)
+		}
+		value := operand.clone()
+		value.XMLName.Local = "value"
+		return value, nil
+	})
+}
@@ -0,0 +1,253 @@
+package gadstest
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/querian/gads"
+)
+
+// testCampaign adds a budget and a campaign named name on the server
+func testCampaign(t *testing.T, s *Server, name string) gads.Campaign {
+	budgets, err := gads.NewBudgetService(s.Auth()).Mutate(gads.BudgetOperations{
+		"ADD": {{Name: "budget " + name, Amount: 50000000, Delivery: "STANDARD"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	campaigns, err := gads.NewCampaignService(s.Auth()).Mutate(gads.CampaignOperations{
+		"ADD": {{
+			Name:                   name,
+			Status:                 "PAUSED",
+			BudgetId:               budgets[0].Id,
+			AdvertisingChannelType: "SEARCH",
+			BiddingStrategyConfiguration: &gads.BiddingStrategyConfiguration{
+				StrategyType: "MANUAL_CPC",
+			},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return campaigns[0]
+}
+
+func TestServerGet(t *testing.T) {
+	cs, s := NewService(t, gads.NewCampaignService)
+	for _, name := range []string{"c", "a", "d", "b"} {
+		testCampaign(t, s, "campaign "+name)
+	}
+
+	campaigns, totalCount, err := cs.Get(gads.Selector{
+		Fields: []string{"Id", "Name"},
+		Predicates: []gads.Predicate{
+			{Field: "Name", Operator: "IN", Values: []string{"campaign a", "campaign b", "campaign c"}},
+			{Field: "Status", Operator: "EQUALS", Values: []string{"PAUSED"}},
+		},
+		Ordering: []gads.OrderBy{{Field: "Name", SortOrder: "DESCENDING"}},
+		Paging:   &gads.Paging{Offset: 1, Limit: 5},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if totalCount != 3 || len(campaigns) != 2 {
+		t.Fatalf("expected 2 campaigns out of 3, got %d out of %d", len(campaigns), totalCount)
+	}
+	if campaigns[0].Name != "campaign b" || campaigns[1].Name != "campaign a" {
+		t.Errorf("unexpected order %s, %s", campaigns[0].Name, campaigns[1].Name)
+	}
+	if campaigns[0].Id == 0 || campaigns[0].Status != "" {
+		t.Errorf("only the selected fields must be returned, got %#v", campaigns[0])
+	}
+
+	_, _, err = cs.Get(gads.Selector{Fields: []string{"Id", "Nmae"}})
+	// rejected by the catalog of the fields before reaching the server
+	if _, ok := err.(*gads.SelectorValidationError); !ok {
+		t.Fatalf("expected a selector validation error, got %#v", err)
+	}
+}
+
+func TestServerMutate(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	campaign := testCampaign(t, s, "campaign")
+
+	ags := gads.NewAdGroupService(s.Auth())
+	adGroups, err := ags.Mutate(gads.AdGroupOperations{
+		"ADD": {{Name: "ad group", CampaignId: campaign.Id}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if adGroups[0].Id == 0 || adGroups[0].CampaignName != "campaign" || adGroups[0].Status != "ENABLED" {
+		t.Errorf("unexpected ad group %#v", adGroups[0])
+	}
+
+	agcs := gads.NewAdGroupCriterionService(s.Auth())
+	criteria, err := agcs.Mutate(gads.AdGroupCriterionOperations{
+		"ADD": {
+			gads.BiddableAdGroupCriterion{
+				AdGroupId: adGroups[0].Id,
+				Criterion: gads.KeywordCriterion{Text: "test1", MatchType: "EXACT"},
+			},
+			gads.NegativeAdGroupCriterion{
+				AdGroupId: adGroups[0].Id,
+				Criterion: gads.KeywordCriterion{Text: "test2", MatchType: "BROAD"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(criteria) != 2 {
+		t.Fatalf("expected 2 criteria, got %#v", criteria)
+	}
+	keyword, ok := criteria[0].(gads.BiddableAdGroupCriterion).Criterion.(gads.KeywordCriterion)
+	if !ok || keyword.Id == 0 || keyword.Text != "test1" {
+		t.Errorf("unexpected criterion %#v", criteria[0])
+	}
+
+	if _, err := agcs.Mutate(gads.AdGroupCriterionOperations{"REMOVE": criteria[:1]}); err != nil {
+		t.Fatal(err)
+	}
+	_, totalCount, err := agcs.Get(gads.Selector{
+		Fields:     []string{"Id", "KeywordText"},
+		Predicates: []gads.Predicate{{Field: "AdGroupId", Operator: "EQUALS", Values: []string{itoa(adGroups[0].Id)}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if totalCount != 1 {
+		t.Errorf("expected the removed criterion to be gone, found %d criteria", totalCount)
+	}
+
+	// a SET only changes the fields sent
+	campaign.Name = ""
+	campaign.Status = "ENABLED"
+	campaigns, err := gads.NewCampaignService(s.Auth()).Mutate(gads.CampaignOperations{"SET": {campaign}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if campaigns[0].Name != "campaign" || campaigns[0].Status != "ENABLED" {
+		t.Errorf("unexpected campaign %#v", campaigns[0])
+	}
+}
+
+func TestServerLabels(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	campaign := testCampaign(t, s, "labelled")
+	testCampaign(t, s, "not labelled")
+
+	labels, err := gads.NewLabelService(s.Auth()).Mutate(gads.LabelOperations{
+		"ADD": {gads.NewTextLabel("label")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs := gads.NewCampaignService(s.Auth())
+	_, err = cs.MutateLabel(gads.CampaignLabelOperations{
+		"ADD": {{CampaignId: campaign.Id, LabelId: labels[0].Id}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	campaigns, _, err := cs.Get(gads.Selector{
+		Fields:     []string{"Id", "Labels"},
+		Predicates: []gads.Predicate{{Field: "Labels", Operator: "CONTAINS_ANY", Values: []string{itoa(labels[0].Id)}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(campaigns) != 1 || campaigns[0].Id != campaign.Id {
+		t.Fatalf("expected the labelled campaign, got %#v", campaigns)
+	}
+	if len(campaigns[0].Labels) != 1 || campaigns[0].Labels[0].Name != "label" {
+		t.Errorf("unexpected labels %#v", campaigns[0].Labels)
+	}
+}
+
+func TestServerFaults(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	campaign := testCampaign(t, s, "campaign")
+
+	ags := gads.NewAdGroupService(s.Auth())
+	ops := gads.AdGroupOperations{
+		"ADD": {
+			{Name: "ad group", CampaignId: campaign.Id},
+			{Name: "orphan", CampaignId: 42},
+		},
+	}
+	_, err := ags.Mutate(ops)
+	fault, ok := err.(*gads.ErrorsType)
+	if !ok {
+		t.Fatalf("expected a fault, got %#v", err)
+	}
+	apiError, ok := fault.ApiExceptionFaults[0].Errors[0].(gads.UnknownError)
+	if !ok || apiError.ErrorString != "EntityNotFound.INVALID_ID" || apiError.FieldPath != "operations[1].operand.campaignId" {
+		t.Errorf("unexpected error %#v", fault.ApiExceptionFaults[0].Errors)
+	}
+	if _, totalCount, _ := ags.Get(gads.Selector{Fields: []string{"Id"}}); totalCount != 0 {
+		t.Errorf("the operations of a failed mutate must not be applied")
+	}
+
+	auth := s.Auth()
+	auth.PartialFailure = true
+	adGroups, err := gads.NewAdGroupService(auth).Mutate(ops)
+	partialErrors, ok := err.(gads.PartialFailureErrors)
+	if !ok || len(partialErrors) != 1 {
+		t.Fatalf("expected a partial failure, got %#v", err)
+	}
+	if offset, _ := partialErrors[0].GetRequestOffset(); offset != 1 {
+		t.Errorf("expected the second operation to fail, got %d", offset)
+	}
+	if len(adGroups) != 2 || adGroups[0].Id == 0 || adGroups[1].Id != 0 {
+		t.Errorf("unexpected ad groups %#v", adGroups)
+	}
+
+	_, err = ags.Mutate(gads.AdGroupOperations{"ADD": {{Name: "ad group", CampaignId: campaign.Id}}})
+	if err == nil || err.Error() != "[AdGroupServiceError.DUPLICATE_ADGROUP_NAME @ operations[0].operand.name; trigger:'ad group']" {
+		t.Errorf("expected a duplicate name fault, got %v", err)
+	}
+}
+
+func TestServerFailNext(t *testing.T) {
+	cs, s := NewService(t, gads.NewCampaignService)
+	s.FailNext(RateExceeded(0))
+	s.FailNext(InternalError())
+	cs.RetryPolicy = &gads.RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond}
+	if _, _, err := cs.Get(gads.Selector{Fields: []string{"Id"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	s.FailNext(InternalError())
+	cs.RetryPolicy = nil
+	_, _, err := cs.Get(gads.Selector{Fields: []string{"Id"}})
+	if fault, ok := err.(*gads.ErrorsType); !ok || len(fault.ApiExceptionFaults[0].Errors) != 1 {
+		t.Fatalf("expected an internal error, got %#v", err)
+	}
+}
+
+func TestServerValidateOnly(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	auth := s.Auth()
+	auth.ValidateOnly = true
+	bs := gads.NewBudgetService(auth)
+	if _, err := bs.Mutate(gads.BudgetOperations{"ADD": {{Name: "budget", Amount: 1000000}}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bs.Mutate(gads.BudgetOperations{"ADD": {{Amount: 1000000}}}); err == nil {
+		t.Error("expected the missing name to be reported")
+	}
+	if _, totalCount, _ := bs.Get(gads.Selector{Fields: []string{"BudgetId"}}); totalCount != 0 {
+		t.Errorf("validated operations must not be applied")
+	}
+}
+
+func itoa(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
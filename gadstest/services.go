@@ -0,0 +1,285 @@
+package gadstest
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// field is a selector field, selected from path and filtered or sorted on
+// the values at filter.
+type field struct {
+	path   string
+	filter string
+}
+
+// kind describes a type of entity kept by the server and the rules the
+// api applies when they are mutated.
+type kind struct {
+	name      string   // as Campaign, used for the page and return value types
+	idPath    string   // path of the id, unique within the parent for criteria
+	parent    string   // kind of the parent entity, if any
+	parentID  string   // path of the id of the parent
+	required  []string // paths to set when adding an entity
+	defaults  map[string]string
+	operators string // supported operators
+
+	// uniqueName is the path of a name unique among the entities not
+	// removed, sharing the same parent, its violation being reported with
+	// duplicateError.
+	uniqueName     string
+	duplicateError [2]string
+
+	// removable entities are deleted by REMOVE operations, the others get
+	// a REMOVED status.
+	removable bool
+
+	labelID string // path of the entity id in the mutateLabel operands
+	fields  map[string]field
+
+	// render sets the fields computed by the api on a copy of an entity
+	render func(s *Server, n *node)
+}
+
+func fields(paths map[string]string) map[string]field {
+	fields := map[string]field{}
+	for name, path := range paths {
+		fields[name] = field{path: path, filter: path}
+	}
+	return fields
+}
+
+// services are the services supported by the server, by name
+var services = map[string]*kind{
+	"BudgetService": {
+		name:           "Budget",
+		idPath:         "budgetId",
+		required:       []string{"name", "amount/microAmount"},
+		defaults:       map[string]string{"status": "ENABLED", "deliveryMethod": "STANDARD", "isExplicitlyShared": "true"},
+		operators:      "ADD SET REMOVE",
+		uniqueName:     "name",
+		duplicateError: [2]string{"BudgetError", "DUPLICATE_NAME"},
+		fields: fields(map[string]string{
+			"BudgetId":                 "budgetId",
+			"BudgetName":               "name",
+			"Amount":                   "amount/microAmount",
+			"DeliveryMethod":           "deliveryMethod",
+			"BudgetReferenceCount":     "referenceCount",
+			"IsBudgetExplicitlyShared": "isExplicitlyShared",
+			"BudgetStatus":             "status",
+		}),
+		render: func(s *Server, n *node) {
+			references := 0
+			for _, c := range s.entities["Campaign"] {
+				if c.node.value("budget/budgetId") == n.value("budgetId") && c.node.value("status") != "REMOVED" {
+					references++
+				}
+			}
+			n.set("referenceCount", strconv.Itoa(references))
+		},
+	},
+	"CampaignService": {
+		name:           "Campaign",
+		idPath:         "id",
+		parent:         "Budget",
+		parentID:       "budget/budgetId",
+		required:       []string{"name", "budget/budgetId", "advertisingChannelType", "biddingStrategyConfiguration"},
+		defaults:       map[string]string{"status": "ENABLED", "servingStatus": "SERVING", "adServingOptimizationStatus": "OPTIMIZE"},
+		operators:      "ADD SET",
+		uniqueName:     "name",
+		duplicateError: [2]string{"CampaignError", "DUPLICATE_CAMPAIGN_NAME"},
+		labelID:        "campaignId",
+		fields: withLabels(fields(map[string]string{
+			"Id":                          "id",
+			"Name":                        "name",
+			"Status":                      "status",
+			"ServingStatus":               "servingStatus",
+			"StartDate":                   "startDate",
+			"EndDate":                     "endDate",
+			"BudgetId":                    "budget/budgetId",
+			"AdServingOptimizationStatus": "adServingOptimizationStatus",
+			"Settings":                    "settings",
+			"AdvertisingChannelType":      "advertisingChannelType",
+			"AdvertisingChannelSubType":   "advertisingChannelSubType",
+			"TrackingUrlTemplate":         "trackingUrlTemplate",
+			"UrlCustomParameters":         "urlCustomParameters",
+			"BiddingStrategyId":           "biddingStrategyConfiguration/biddingStrategyId",
+			"BiddingStrategyName":         "biddingStrategyConfiguration/biddingStrategyName",
+			"BiddingStrategyType":         "biddingStrategyConfiguration/biddingStrategyType",
+			"FrequencyCapMaxImpressions":  "frequencyCap/impressions",
+			"TargetGoogleSearch":          "networkSetting/targetGoogleSearch",
+			"TargetSearchNetwork":         "networkSetting/targetSearchNetwork",
+			"TargetContentNetwork":        "networkSetting/targetContentNetwork",
+			"TargetPartnerSearchNetwork":  "networkSetting/targetPartnerSearchNetwork",
+			"BaseCampaignId":              "baseCampaignId",
+			"CampaignTrialType":           "campaignTrialType",
+		})),
+	},
+	"AdGroupService": {
+		name:           "AdGroup",
+		idPath:         "id",
+		parent:         "Campaign",
+		parentID:       "campaignId",
+		required:       []string{"name", "campaignId"},
+		defaults:       map[string]string{"status": "ENABLED"},
+		operators:      "ADD SET",
+		uniqueName:     "name",
+		duplicateError: [2]string{"AdGroupServiceError", "DUPLICATE_ADGROUP_NAME"},
+		labelID:        "adGroupId",
+		fields: withLabels(fields(map[string]string{
+			"Id":                           "id",
+			"CampaignId":                   "campaignId",
+			"CampaignName":                 "campaignName",
+			"Name":                         "name",
+			"Status":                       "status",
+			"Settings":                     "settings",
+			"TrackingUrlTemplate":          "trackingUrlTemplate",
+			"ContentBidCriterionTypeGroup": "contentBidCriterionTypeGroup",
+			"BiddingStrategyId":            "biddingStrategyConfiguration/biddingStrategyId",
+			"BiddingStrategyName":          "biddingStrategyConfiguration/biddingStrategyName",
+			"BiddingStrategyType":          "biddingStrategyConfiguration/biddingStrategyType",
+			"CpcBid":                       "biddingStrategyConfiguration/bids/bid/microAmount",
+		})),
+		render: func(s *Server, n *node) {
+			for _, c := range s.entities["Campaign"] {
+				if c.node.value("id") == n.value("campaignId") {
+					n.set("campaignName", c.node.value("name"))
+				}
+			}
+		},
+	},
+	"AdGroupCriterionService": {
+		name:      "AdGroupCriterion",
+		idPath:    "criterion/id",
+		parent:    "AdGroup",
+		parentID:  "adGroupId",
+		required:  []string{"adGroupId", "criterion"},
+		operators: "ADD SET REMOVE",
+		removable: true,
+		labelID:   "adGroupCriterionId",
+		fields: withLabels(criterionFields(map[string]string{
+			"AdGroupId":           "adGroupId",
+			"CriterionUse":        "criterionUse",
+			"Status":              "userStatus",
+			"SystemServingStatus": "systemServingStatus",
+			"ApprovalStatus":      "approvalStatus",
+			"CpcBid":              "biddingStrategyConfiguration/bids/bid/microAmount",
+			"BidModifier":         "bidModifier",
+			"FinalUrls":           "finalUrls",
+			"TrackingUrlTemplate": "trackingUrlTemplate",
+		})),
+		render: func(s *Server, n *node) {
+			renderCriterion(n)
+			if n.xsiType() == "NegativeAdGroupCriterion" {
+				n.set("criterionUse", "NEGATIVE")
+				return
+			}
+			n.set("criterionUse", "BIDDABLE")
+			for path, value := range map[string]string{
+				"userStatus":          "ENABLED",
+				"systemServingStatus": "ELIGIBLE",
+				"approvalStatus":      "APPROVED",
+			} {
+				if n.value(path) == "" {
+					n.set(path, value)
+				}
+			}
+		},
+	},
+	"CampaignCriterionService": {
+		name:      "CampaignCriterion",
+		idPath:    "criterion/id",
+		parent:    "Campaign",
+		parentID:  "campaignId",
+		required:  []string{"campaignId", "criterion"},
+		operators: "ADD SET REMOVE",
+		removable: true,
+		fields: criterionFields(map[string]string{
+			"CampaignId":  "campaignId",
+			"IsNegative":  "isNegative",
+			"BidModifier": "bidModifier",
+		}),
+		render: func(s *Server, n *node) {
+			renderCriterion(n)
+			n.set("isNegative", strconv.FormatBool(n.xsiType() == "NegativeCampaignCriterion" || n.value("isNegative") == "true"))
+		},
+	},
+	"LabelService": {
+		name:           "Label",
+		idPath:         "id",
+		required:       []string{"name"},
+		defaults:       map[string]string{"status": "ENABLED"},
+		operators:      "ADD SET REMOVE",
+		uniqueName:     "name",
+		duplicateError: [2]string{"LabelError", "DUPLICATE_NAME"},
+		fields: fields(map[string]string{
+			"LabelId":     "id",
+			"LabelName":   "name",
+			"LabelStatus": "status",
+		}),
+	},
+}
+
+// withLabels adds the Labels field, filtered on the label ids
+func withLabels(fields map[string]field) map[string]field {
+	fields["Labels"] = field{path: "labels", filter: "labels/id"}
+	return fields
+}
+
+// criterionFields adds the fields of the criteria to fields
+func criterionFields(paths map[string]string) map[string]field {
+	for name, path := range map[string]string{
+		"Id":                  "criterion/id",
+		"CriteriaType":        "criterion/type",
+		"KeywordText":         "criterion/text",
+		"KeywordMatchType":    "criterion/matchType",
+		"PlacementUrl":        "criterion/url",
+		"AgeRangeType":        "criterion/ageRangeType",
+		"GenderType":          "criterion/genderType",
+		"UserListId":          "criterion/userListId",
+		"UserInterestId":      "criterion/userInterestId",
+		"VerticalId":          "criterion/verticalId",
+		"LocationName":        "criterion/locationName",
+		"DisplayType":         "criterion/displayType",
+		"LanguageCode":        "criterion/code",
+		"LanguageName":        "criterion/name",
+		"PlatformName":        "criterion/platformName",
+		"RadiusInUnits":       "criterion/radiusInUnits",
+		"RadiusDistanceUnits": "criterion/radiusDistanceUnits",
+		"Address":             "criterion/address",
+		"GeoPoint":            "criterion/geoPoint",
+		"DayOfWeek":           "criterion/dayOfWeek",
+		"StartHour":           "criterion/startHour",
+		"EndHour":             "criterion/endHour",
+	} {
+		paths[name] = path
+	}
+	return fields(paths)
+}
+
+// renderCriterion sets the type of the criterion of n, as KEYWORD for a
+// Keyword
+func renderCriterion(n *node) {
+	criteria := n.children("criterion")
+	if len(criteria) == 0 {
+		return
+	}
+	criterionType := []rune{}
+	for i, r := range criteria[0].xsiType() {
+		if i > 0 && unicode.IsUpper(r) {
+			criterionType = append(criterionType, '_')
+		}
+		criterionType = append(criterionType, unicode.ToUpper(r))
+	}
+	criteria[0].set("type", string(criterionType))
+}
+
+// supports tells if the kind supports the operator
+func (k *kind) supports(operator string) bool {
+	for _, o := range strings.Fields(k.operators) {
+		if o == operator {
+			return true
+		}
+	}
+	return false
+}
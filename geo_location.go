@@ -1,5 +1,10 @@
 package gads
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 type GeoLocationService struct {
 	Auth
 }
@@ -7,3 +12,123 @@ type GeoLocationService struct {
 func NewGeoLocationService(auth *Auth) *GeoLocationService {
 	return &GeoLocationService{Auth: *auth}
 }
+
+// GeoLocationSelector lists the addresses to locate, Locale is the
+// language of the canonical addresses returned.
+type GeoLocationSelector struct {
+	XMLName   xml.Name
+	Addresses []Address `xml:"addresses"`
+	Locale    string    `xml:"locale,omitempty"`
+}
+
+// GeoLocation is the point of an address and its canonical form, Type is
+// InvalidGeoLocation if the address could not be located.
+type GeoLocation struct {
+	Type            string   `xml:"http://www.w3.org/2001/XMLSchema-instance type,attr"`
+	GeoPoint        GeoPoint `xml:"geoPoint"`
+	Address         Address  `xml:"address"`
+	EncodedLocation string   `xml:"encodedLocation"`
+}
+
+// Valid tells if the address was located
+func (l GeoLocation) Valid() bool {
+	return l.Type != "InvalidGeoLocation"
+}
+
+// Get returns the locations of the addresses of the selector, in the same
+// order.
+//
+// Example
+//
+//   geoLocations, err := geoLocationService.Get(
+//     gads.GeoLocationSelector{
+//       Addresses: []gads.Address{
+//         {StreetAddress: "1600 Amphitheatre Parkway", CityName: "Mountain View", ProvinceCode: "US-CA", CountryCode: "US"},
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/GeoLocationService#get
+//
+func (s *GeoLocationService) Get(selector GeoLocationSelector) (geoLocations []GeoLocation, err error) {
+	selector.XMLName = xml.Name{"", "selector"}
+	respBody, err := s.Auth.request(
+		geoLocationServiceUrl,
+		"get",
+		struct {
+			XMLName xml.Name
+			Sel     GeoLocationSelector
+		}{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(geoLocationServiceUrl),
+				Local: "get",
+			},
+			Sel: selector,
+		},
+	)
+	if err != nil {
+		return geoLocations, err
+	}
+	getResp := struct {
+		GeoLocations []GeoLocation `xml:"rval"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return geoLocations, err
+	}
+	return getResp.GeoLocations, err
+}
+
+// InvalidAddressesError lists the addresses which could not be located
+type InvalidAddressesError struct {
+	Addresses []Address
+}
+
+func (e *InvalidAddressesError) Error() string {
+	return fmt.Sprintf("%d addresses could not be located, as %+v", len(e.Addresses), e.Addresses[0])
+}
+
+// ProximityCriteria locates the addresses of stores and returns the
+// criteria targeting the area of radius around each of them, in units
+// (KILOMETERS or MILES). The addresses which could not be located are
+// skipped and reported by an *InvalidAddressesError.
+//
+// Example
+//
+//   criteria, err := geoLocationService.ProximityCriteria(storeAddresses, 5, "KILOMETERS")
+//   if _, ok := err.(*gads.InvalidAddressesError); err != nil && !ok {
+//     return err
+//   }
+//   operations := gads.CampaignCriterionOperations{}
+//   for _, criterion := range criteria {
+//     operations["ADD"] = append(operations["ADD"], gads.CampaignCriterion{CampaignId: campaignId, Criterion: criterion})
+//   }
+//
+func (s *GeoLocationService) ProximityCriteria(addresses []Address, radius float64, units string) (criteria []ProximityCriterion, err error) {
+	geoLocations, err := s.Get(GeoLocationSelector{Addresses: addresses})
+	if err != nil {
+		return criteria, err
+	}
+	if len(geoLocations) != len(addresses) {
+		return criteria, fmt.Errorf("%d locations returned for %d addresses", len(geoLocations), len(addresses))
+	}
+	invalid := []Address{}
+	for i, geoLocation := range geoLocations {
+		if !geoLocation.Valid() {
+			invalid = append(invalid, addresses[i])
+			continue
+		}
+		criteria = append(criteria, ProximityCriterion{
+			GeoPoint:            geoLocation.GeoPoint,
+			RadiusDistanceUnits: units,
+			RadiusInUnits:       radius,
+			Address:             geoLocation.Address,
+		})
+	}
+	if len(invalid) > 0 {
+		return criteria, &InvalidAddressesError{Addresses: invalid}
+	}
+	return criteria, nil
+}
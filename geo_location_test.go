@@ -0,0 +1,75 @@
+package gads
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const testGeoLocationResponse = `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Body>
+    <getResponse xmlns="https://adwords.google.com/api/adwords/cm/v201806" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">
+      <rval xsi:type="GeoLocation">
+        <geoPoint>
+          <latitudeInMicroDegrees>37421759</latitudeInMicroDegrees>
+          <longitudeInMicroDegrees>-122084057</longitudeInMicroDegrees>
+        </geoPoint>
+        <address>
+          <streetAddress>1600 Amphitheatre Pkwy</streetAddress>
+          <cityName>Mountain View</cityName>
+          <provinceCode>US-CA</provinceCode>
+          <provinceName>California</provinceName>
+          <postalCode>94043</postalCode>
+          <countryCode>US</countryCode>
+        </address>
+        <encodedLocation>ChQKDQ</encodedLocation>
+        <GeoLocation.Type>GeoLocation</GeoLocation.Type>
+      </rval>
+      <rval xsi:type="InvalidGeoLocation">
+        <GeoLocation.Type>InvalidGeoLocation</GeoLocation.Type>
+      </rval>
+    </getResponse>
+  </soap:Body>
+</soap:Envelope>`
+
+func TestGeoLocationProximityCriteria(t *testing.T) {
+	var body string
+	auth, cleanup := testServerAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		fmt.Fprint(w, testGeoLocationResponse)
+	})
+	defer cleanup()
+
+	addresses := []Address{
+		{StreetAddress: "1600 Amphitheatre Parkway", CityName: "Mountain View", CountryCode: "US"},
+		{StreetAddress: "nowhere", CountryCode: "US"},
+	}
+	criteria, err := NewGeoLocationService(&auth).ProximityCriteria(addresses, 5, "KILOMETERS")
+	invalid, ok := err.(*InvalidAddressesError)
+	if !ok || len(invalid.Addresses) != 1 || invalid.Addresses[0].StreetAddress != "nowhere" {
+		t.Fatalf("expected the second address to be reported, got %v", err)
+	}
+	if strings.Count(body, "<addresses>") != 2 || !strings.Contains(body, "<cityName>Mountain View</cityName>") {
+		t.Errorf("unexpected request\n%s", body)
+	}
+
+	expected := ProximityCriterion{
+		GeoPoint:            GeoPoint{Latitude: 37421759, Longitude: -122084057},
+		RadiusDistanceUnits: "KILOMETERS",
+		RadiusInUnits:       5,
+		Address: Address{
+			StreetAddress: "1600 Amphitheatre Pkwy",
+			CityName:      "Mountain View",
+			ProvinceCode:  "US-CA",
+			ProvinceName:  "California",
+			PostalCode:    "94043",
+			CountryCode:   "US",
+		},
+	}
+	if len(criteria) != 1 || criteria[0] != expected {
+		t.Errorf("expected the criteria %#v, got %#v", expected, criteria)
+	}
+}
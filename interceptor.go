@@ -0,0 +1,137 @@
+package gads
+
+import (
+	"net/http"
+	"regexp"
+)
+
+// Interceptor is a middleware plugged on the soap requests of an Auth, to
+// log, measure, sign or alter them.
+//
+// The interceptors of an Auth are called in order, each one receiving the
+// result of the previous one.
+type Interceptor interface {
+	// BeforeSend is called before req, holding the soap envelope body, is
+	// sent. Returning an error aborts the request.
+	BeforeSend(req *http.Request, body []byte) error
+
+	// AfterReceive is called with the raw response of req before it is
+	// decoded, and returns the body to decode in place of body.
+	AfterReceive(req *http.Request, resp *http.Response, body []byte) ([]byte, error)
+
+	// OnError is called when req fails, whatever the reason, faults
+	// returned by the api included.
+	OnError(req *http.Request, err error)
+}
+
+// InterceptorFuncs is an Interceptor built from functions, the nil ones
+// doing nothing.
+//
+// Example
+//
+//   auth.Interceptors = append(auth.Interceptors, gads.InterceptorFuncs{
+//     OnErrorFunc: func(req *http.Request, err error) {
+//       failures.WithLabelValues(req.Header.Get("SOAPAction")).Inc()
+//     },
+//   })
+//
+type InterceptorFuncs struct {
+	BeforeSendFunc   func(req *http.Request, body []byte) error
+	AfterReceiveFunc func(req *http.Request, resp *http.Response, body []byte) ([]byte, error)
+	OnErrorFunc      func(req *http.Request, err error)
+}
+
+// BeforeSend calls BeforeSendFunc if set
+func (i InterceptorFuncs) BeforeSend(req *http.Request, body []byte) error {
+	if i.BeforeSendFunc == nil {
+		return nil
+	}
+	return i.BeforeSendFunc(req, body)
+}
+
+// AfterReceive calls AfterReceiveFunc if set
+func (i InterceptorFuncs) AfterReceive(req *http.Request, resp *http.Response, body []byte) ([]byte, error) {
+	if i.AfterReceiveFunc == nil {
+		return body, nil
+	}
+	return i.AfterReceiveFunc(req, resp, body)
+}
+
+// OnError calls OnErrorFunc if set
+func (i InterceptorFuncs) OnError(req *http.Request, err error) {
+	if i.OnErrorFunc != nil {
+		i.OnErrorFunc(req, err)
+	}
+}
+
+// Logger is the interface of the loggers given to NewLoggingInterceptor,
+// it is implemented by *log.Logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// LoggerFunc adapts a printf like function, as testing.T.Logf, to Logger.
+type LoggerFunc func(format string, v ...interface{})
+
+// Printf calls f
+func (f LoggerFunc) Printf(format string, v ...interface{}) {
+	f(format, v...)
+}
+
+// NewLoggingInterceptor returns an Interceptor dumping the requests and
+// responses to logger. The developer token and the oauth bearer are
+// redacted from the dump.
+func NewLoggingInterceptor(logger Logger) Interceptor {
+	return loggingInterceptor{logger}
+}
+
+type loggingInterceptor struct {
+	logger Logger
+}
+
+const redacted = "REDACTED"
+
+var developerTokenParse = regexp.MustCompile(`(<(?:[^:<>]+:)?developerToken>)[^<]*(</)`)
+
+// redactedHeader returns a copy of header without its secrets
+func redactedHeader(header http.Header) http.Header {
+	redactedHeader := http.Header{}
+	for key, values := range header {
+		switch http.CanonicalHeaderKey(key) {
+		case "Authorization", "Developertoken":
+			redactedHeader[key] = []string{redacted}
+		default:
+			redactedHeader[key] = values
+		}
+	}
+	return redactedHeader
+}
+
+// redactedBody returns a copy of a soap envelope without the developer token
+func redactedBody(body []byte) []byte {
+	return developerTokenParse.ReplaceAll(body, []byte("${1}"+redacted+"${2}"))
+}
+
+func (l loggingInterceptor) BeforeSend(req *http.Request, body []byte) error {
+	l.logger.Printf("request ->\n%s\n%#v\n%s\n", req.URL.String(), redactedHeader(req.Header), redactedBody(body))
+	return nil
+}
+
+func (l loggingInterceptor) AfterReceive(req *http.Request, resp *http.Response, body []byte) ([]byte, error) {
+	l.logger.Printf("respBody ->\n%s\n%s\n", body, resp.Status)
+	return body, nil
+}
+
+func (l loggingInterceptor) OnError(req *http.Request, err error) {
+	l.logger.Printf("error ->\n%s\n%s\n", req.URL.String(), err)
+}
+
+// interceptors returns the interceptors to call around the requests,
+// logging them in the test output when Testing is set
+func (a *Auth) interceptors() []Interceptor {
+	if a.Testing == nil {
+		return a.Interceptors
+	}
+	interceptors := []Interceptor{NewLoggingInterceptor(LoggerFunc(a.Testing.Logf))}
+	return append(interceptors, a.Interceptors...)
+}
@@ -0,0 +1,129 @@
+package gads
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestInterceptors(t *testing.T) {
+	auth, cleanup := testServerAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		ioutil.ReadAll(r.Body)
+		if r.Header.Get("X-Signature") != "signed" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		fmt.Fprint(w, testCampaignGetResponse)
+	})
+	defer cleanup()
+
+	calls := []string{}
+	auth.Interceptors = []Interceptor{
+		InterceptorFuncs{
+			BeforeSendFunc: func(req *http.Request, body []byte) error {
+				calls = append(calls, "sign")
+				req.Header.Set("X-Signature", "signed")
+				return nil
+			},
+		},
+		InterceptorFuncs{
+			BeforeSendFunc: func(req *http.Request, body []byte) error {
+				calls = append(calls, "before "+req.Header.Get("SOAPAction"))
+				return nil
+			},
+			AfterReceiveFunc: func(req *http.Request, resp *http.Response, body []byte) ([]byte, error) {
+				calls = append(calls, "after "+resp.Status)
+				return body, nil
+			},
+			OnErrorFunc: func(req *http.Request, err error) {
+				calls = append(calls, "error")
+			},
+		},
+	}
+
+	if _, _, err := NewCampaignService(&auth).Get(Selector{Fields: []string{"Id"}}); err != nil {
+		t.Fatal(err)
+	}
+	expected := "sign, before get, after 200 OK"
+	if strings.Join(calls, ", ") != expected {
+		t.Errorf("expected the calls %q, got %q", expected, calls)
+	}
+}
+
+func TestInterceptorsFaultInjection(t *testing.T) {
+	auth, cleanup := testServerAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		ioutil.ReadAll(r.Body)
+		fmt.Fprint(w, testCampaignGetResponse)
+	})
+	defer cleanup()
+
+	errs := []error{}
+	auth.Interceptors = []Interceptor{
+		InterceptorFuncs{
+			AfterReceiveFunc: func(req *http.Request, resp *http.Response, body []byte) ([]byte, error) {
+				resp.StatusCode = http.StatusInternalServerError
+				return []byte(testInternalApiFault), nil
+			},
+			OnErrorFunc: func(req *http.Request, err error) {
+				errs = append(errs, err)
+			},
+		},
+	}
+	_, _, err := NewCampaignService(&auth).Get(Selector{Fields: []string{"Id"}})
+	if _, ok := err.(*ErrorsType); !ok {
+		t.Fatalf("expected the injected fault, got %#v", err)
+	}
+	if len(errs) != 1 || errs[0] != err {
+		t.Errorf("expected OnError to be called with the fault, got %#v", errs)
+	}
+
+	aborted := errors.New("aborted")
+	auth.Interceptors = []Interceptor{
+		InterceptorFuncs{
+			BeforeSendFunc: func(req *http.Request, body []byte) error {
+				return aborted
+			},
+		},
+	}
+	if _, _, err = NewCampaignService(&auth).Get(Selector{Fields: []string{"Id"}}); err != aborted {
+		t.Errorf("expected the request to be aborted, got %#v", err)
+	}
+}
+
+func TestLoggingInterceptor(t *testing.T) {
+	auth, cleanup := testServerAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		ioutil.ReadAll(r.Body)
+		fmt.Fprint(w, testCampaignGetResponse)
+	})
+	defer cleanup()
+
+	var logs bytes.Buffer
+	auth.DeveloperToken = "s3cr3t-developer-token"
+	auth.Interceptors = []Interceptor{
+		InterceptorFuncs{
+			BeforeSendFunc: func(req *http.Request, body []byte) error {
+				req.Header.Set("Authorization", "Bearer s3cr3t-access-token")
+				return nil
+			},
+		},
+		NewLoggingInterceptor(LoggerFunc(func(format string, v ...interface{}) {
+			fmt.Fprintf(&logs, format, v...)
+		})),
+	}
+	if _, _, err := NewCampaignService(&auth).Get(Selector{Fields: []string{"Id"}}); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(logs.String(), "s3cr3t") {
+		t.Errorf("secrets must be redacted from the logs\n%s", logs.String())
+	}
+	if !strings.Contains(logs.String(), "<developerToken>"+redacted+"</developerToken>") {
+		t.Errorf("expected the developer token to be redacted\n%s", logs.String())
+	}
+	if !strings.Contains(logs.String(), "test campaign") {
+		t.Errorf("expected the response to be logged\n%s", logs.String())
+	}
+}
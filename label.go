@@ -31,6 +31,18 @@ func NewTextLabel(name string) Label {
 // LabelOperations is a map of operations to perform on Label's
 type LabelOperations map[string][]Label
 
+// LabelFields lists the fields of the labels the LabelService selects and
+// filters on, see FieldCatalog.
+var LabelFields = FieldCatalog{
+	Service: "LabelService",
+	Selectable: []string{
+		"LabelId", "LabelName", "LabelStatus", "LabelAttribute",
+	},
+	Filterable: []string{
+		"LabelId", "LabelName", "LabelStatus",
+	},
+}
+
 // Get returns an array of Label's and the total number of Label's matching
 // the selector.
 //
@@ -56,6 +68,9 @@ type LabelOperations map[string][]Label
 //     https://developers.google.com/adwords/api/docs/reference/v201806/LabelService#get
 //
 func (s LabelService) Get(selector Selector) (labels []Label, totalCount int64, err error) {
+	if err := LabelFields.Validate(selector); err != nil {
+		return labels, totalCount, err
+	}
 	selector.XMLName = xml.Name{"", "serviceSelector"}
 	respBody, err := s.Auth.request(
 		labelServiceUrl,
@@ -65,7 +80,7 @@ func (s LabelService) Get(selector Selector) (labels []Label, totalCount int64,
 			Sel     Selector
 		}{
 			XMLName: xml.Name{
-				Space: baseUrl,
+				Space: s.Auth.namespace(labelServiceUrl),
 				Local: "get",
 			},
 			Sel: selector,
@@ -85,6 +100,11 @@ func (s LabelService) Get(selector Selector) (labels []Label, totalCount int64,
 	return getResp.Labels, getResp.Size, err
 }
 
+// All returns a Pager over all the labels matching selector.
+func (s LabelService) All(selector Selector) *Pager[Label] {
+	return NewPager(s.Get, selector)
+}
+
 // Mutate allows you to add, modify and remove labels, returning the
 // modified labels.
 //
@@ -130,7 +150,7 @@ func (s *LabelService) Mutate(labelOperations LabelOperations) (labels []Label,
 		Ops     []labelOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
-			Space: baseUrl,
+			Space: s.Auth.namespace(labelServiceUrl),
 			Local: "mutate",
 		},
 		Ops: operations,
@@ -155,12 +175,35 @@ func (s *LabelService) Mutate(labelOperations LabelOperations) (labels []Label,
 	return mutateResp.Labels, err
 }
 
-// Query is not yet implemented
+// Query returns the labels matching the awql query, and their
+// total count regardless of the LIMIT clause.
 //
 // Relevant documentation
 //
 //     https://developers.google.com/adwords/api/docs/reference/v201806/LabelService#query
 //
 func (s *LabelService) Query(query string) (labels []Label, totalCount int64, err error) {
-	return labels, totalCount, ERROR_NOT_YET_IMPLEMENTED
+	respBody, err := s.Auth.request(
+		labelServiceUrl,
+		"query",
+		AWQLQuery{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(labelServiceUrl),
+				Local: "query",
+			},
+			Query: query,
+		},
+	)
+	if err != nil {
+		return labels, totalCount, err
+	}
+	queryResp := struct {
+		Size   int64   `xml:"rval>totalNumEntries"`
+		Labels []Label `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &queryResp)
+	if err != nil {
+		return labels, totalCount, err
+	}
+	return queryResp.Labels, queryResp.Size, err
 }
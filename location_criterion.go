@@ -31,7 +31,7 @@ func (s *LocationCriterionService) Get(selector Selector) (locationCriterions Lo
 			Sel     Selector
 		}{
 			XMLName: xml.Name{
-				Space: baseUrl,
+				Space: s.Auth.namespace(locationCriterionServiceUrl),
 				Local: "get",
 			},
 			Sel: selector,
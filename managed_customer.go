@@ -65,6 +65,20 @@ type ManagedCustomerMoveOperation struct {
 	Link                 ManagedCustomerLink
 }
 
+// ManagedCustomerFields lists the fields of the managed customers the
+// ManagedCustomerService selects and filters on, see FieldCatalog.
+var ManagedCustomerFields = FieldCatalog{
+	Service: "ManagedCustomerService",
+	Selectable: []string{
+		"Name", "CustomerId", "CanManageClients", "CurrencyCode", "DateTimeZone", "TestAccount",
+		"AccountLabels", "ExcludeHiddenAccounts",
+	},
+	Filterable: []string{
+		"Name", "CustomerId", "CanManageClients", "CurrencyCode", "TestAccount", "AccountLabels",
+		"ExcludeHiddenAccounts",
+	},
+}
+
 // Get fetches the managed customers of the current account
 func (m *ManagedCustomerService) Get(selector Selector) (
 	customers []ManagedCustomer,
@@ -72,6 +86,9 @@ func (m *ManagedCustomerService) Get(selector Selector) (
 	totalCount int64,
 	err error,
 ) {
+	if err := ManagedCustomerFields.Validate(selector); err != nil {
+		return customers, managedCustomerLinks, totalCount, err
+	}
 	selector.XMLName = xml.Name{"", "serviceSelector"}
 	var respBody []byte
 	respBody, err = m.Auth.request(
@@ -82,7 +99,7 @@ func (m *ManagedCustomerService) Get(selector Selector) (
 			Sel     Selector
 		}{
 			XMLName: xml.Name{
-				Space: managedCustomerUrl,
+				Space: m.Auth.namespace(managedCustomerServiceUrl),
 				Local: "get",
 			},
 			Sel: selector,
@@ -109,10 +126,19 @@ func (m *ManagedCustomerService) Get(selector Selector) (
 	return getResp.ManagedCustomers, getResp.ManagedCustomerLink, totalCount, err
 }
 
+// All returns a Pager over all the managed customers matching selector,
+// without their links.
+func (m *ManagedCustomerService) All(selector Selector) *Pager[ManagedCustomer] {
+	return NewPager(func(selector Selector) ([]ManagedCustomer, int64, error) {
+		customers, _, totalCount, err := m.Get(selector)
+		return customers, totalCount, err
+	}, selector)
+}
+
 // MutateManager takes a budgetOperations and creates, modifies or destroys the associated budgets.
 func (m *ManagedCustomerService) MutateManager(mcmOps ManagedCustomerMoveOperations) (links []ManagedCustomerLink, err error) {
 	type managedCustomerMoveOperation struct {
-		Action               string              `xml:"https://adwords.google.com/api/adwords/cm/v201806 operator"`
+		Action               cmOperator
 		Link                 ManagedCustomerLink `xml:"operand"`
 		OldManagerCustomerId uint                `xml:"oldManagerCustomerId"`
 	}
@@ -123,7 +149,7 @@ func (m *ManagedCustomerService) MutateManager(mcmOps ManagedCustomerMoveOperati
 			operations = append(
 				operations,
 				managedCustomerMoveOperation{
-					Action:               action,
+					Action:               m.Auth.cmOperator(action),
 					Link:                 op.Link,
 					OldManagerCustomerId: op.OldManagerCustomerId,
 				},
@@ -138,7 +164,7 @@ func (m *ManagedCustomerService) MutateManager(mcmOps ManagedCustomerMoveOperati
 			Ops     []managedCustomerMoveOperation `xml:"operations"`
 		}{
 			XMLName: xml.Name{
-				Space: managedCustomerUrl,
+				Space: m.Auth.namespace(managedCustomerServiceUrl),
 				Local: "mutateManager",
 			},
 			Ops: operations,
@@ -167,7 +193,7 @@ func (m *ManagedCustomerService) MutateManager(mcmOps ManagedCustomerMoveOperati
 func (m *ManagedCustomerService) MutateLink(mcl ManagedCustomerLinkOperations) ([]*ManagedCustomerLink, error) {
 
 	type linkOperation struct {
-		Action string               `xml:"https://adwords.google.com/api/adwords/cm/v201806 operator"`
+		Action cmOperator
 		Link   *ManagedCustomerLink `xml:"operand"`
 	}
 
@@ -177,7 +203,7 @@ func (m *ManagedCustomerService) MutateLink(mcl ManagedCustomerLinkOperations) (
 			operations = append(
 				operations,
 				&linkOperation{
-					Action: action,
+					Action: m.Auth.cmOperator(action),
 					Link:   op,
 				},
 			)
@@ -191,7 +217,7 @@ func (m *ManagedCustomerService) MutateLink(mcl ManagedCustomerLinkOperations) (
 			Ops     []*linkOperation `xml:"operations"`
 		}{
 			XMLName: xml.Name{
-				Space: managedCustomerUrl,
+				Space: m.Auth.namespace(managedCustomerServiceUrl),
 				Local: "mutateLink",
 			},
 			Ops: operations,
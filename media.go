@@ -73,7 +73,24 @@ func NewVideo(mediaType string) (image Media) {
 	}
 }
 
+// MediaFields lists the fields of the medias the MediaService selects and
+// filters on, see FieldCatalog.
+var MediaFields = FieldCatalog{
+	Service: "MediaService",
+	Selectable: []string{
+		"MediaId", "Type", "ReferenceId", "Dimensions", "Urls", "MimeType", "SourceUrl", "Name",
+		"FileSize", "CreationTime", "Industry", "Advertiser", "YouTubeVideoIdString", "StreamingUrl",
+		"ReadyToPlayOnTheWeb", "DurationMillis", "MediaBundleUrl", "MediaBundleEntryPoint",
+	},
+	Filterable: []string{
+		"MediaId", "Type", "ReferenceId", "Name", "MimeType", "FileSize", "CreationTime",
+	},
+}
+
 func (s *MediaService) Get(selector Selector) (medias []Media, totalCount int64, err error) {
+	if err := MediaFields.Validate(selector); err != nil {
+		return medias, totalCount, err
+	}
 	selector.XMLName = xml.Name{"", "serviceSelector"}
 	respBody, err := s.Auth.request(
 		mediaServiceUrl,
@@ -83,7 +100,7 @@ func (s *MediaService) Get(selector Selector) (medias []Media, totalCount int64,
 			Sel     Selector
 		}{
 			XMLName: xml.Name{
-				Space: baseUrl,
+				Space: s.Auth.namespace(mediaServiceUrl),
 				Local: "get",
 			},
 			Sel: selector,
@@ -103,8 +120,42 @@ func (s *MediaService) Get(selector Selector) (medias []Media, totalCount int64,
 	return getResp.Medias, getResp.Size, err
 }
 
+// All returns a Pager over all the medias matching selector.
+func (s *MediaService) All(selector Selector) *Pager[Media] {
+	return NewPager(s.Get, selector)
+}
+
+// Query returns the medias matching the awql query, and their
+// total count regardless of the LIMIT clause.
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/MediaService#query
+//
 func (s *MediaService) Query(query string) (medias []Media, totalCount int64, err error) {
-	return medias, totalCount, ERROR_NOT_YET_IMPLEMENTED
+	respBody, err := s.Auth.request(
+		mediaServiceUrl,
+		"query",
+		AWQLQuery{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(mediaServiceUrl),
+				Local: "query",
+			},
+			Query: query,
+		},
+	)
+	if err != nil {
+		return medias, totalCount, err
+	}
+	queryResp := struct {
+		Size   int64   `xml:"rval>totalNumEntries"`
+		Medias []Media `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &queryResp)
+	if err != nil {
+		return medias, totalCount, err
+	}
+	return queryResp.Medias, queryResp.Size, err
 }
 
 func (s *MediaService) Upload(medias []Media) (uploadedMedias []Media, err error) {
@@ -113,7 +164,7 @@ func (s *MediaService) Upload(medias []Media) (uploadedMedias []Media, err error
 		Medias  []Media `xml:"media"`
 	}{
 		XMLName: xml.Name{
-			Space: baseUrl,
+			Space: s.Auth.namespace(mediaServiceUrl),
 			Local: "upload",
 		},
 		Medias: medias,
@@ -1,9 +1,10 @@
 package gads
 
 import (
+	"bytes"
 	"fmt"
-	"io/ioutil"
-	"net/http"
+	"image"
+	"image/jpeg"
 	"testing"
 )
 
@@ -13,14 +14,12 @@ func testMediaService(t *testing.T) (service *MediaService) {
 
 func TestMedia(t *testing.T) {
 
-	// load image into []byte
-	imageUrl := "https://orig00.deviantart.net/7088/f/2009/240/5/8/582121d950a606ed47bda5e181a770af.jpg"
-	resp, err := http.Get(imageUrl)
-	if err != nil {
-		panic(err)
+	// encode an image into []byte
+	var jpg bytes.Buffer
+	if err := jpeg.Encode(&jpg, image.NewGray(image.Rect(0, 0, 64, 64)), nil); err != nil {
+		t.Fatal(err)
 	}
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
+	body := jpg.Bytes()
 
 	ms := testMediaService(t)
 	images, err := ms.Upload(
@@ -45,8 +44,7 @@ func TestMedia(t *testing.T) {
 			Selector{
 				Fields: []string{
 					"MediaId",
-					"Height",
-					"Width",
+					"Dimensions",
 					"MimeType",
 					"Urls",
 				},
@@ -1,9 +1,9 @@
 package gads
 
-type MutateJobService struct {
-	Auth
-}
+// MutateJobService is the former name of the BatchJobService
+type MutateJobService = BatchJobService
 
+// NewMutateJobService returns a BatchJobService, see NewBatchJobService.
 func NewMutateJobService(auth *Auth) *MutateJobService {
-	return &MutateJobService{Auth: *auth}
+	return NewBatchJobService(auth)
 }
@@ -1,9 +1,293 @@
 package gads
 
-type OfflineConversionService struct {
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type OfflineConversionFeedService struct {
 	Auth
 }
 
+func NewOfflineConversionFeedService(auth *Auth) *OfflineConversionFeedService {
+	return &OfflineConversionFeedService{Auth: *auth}
+}
+
+// OfflineConversionService is the former name of the
+// OfflineConversionFeedService.
+type OfflineConversionService = OfflineConversionFeedService
+
 func NewOfflineConversionService(auth *Auth) *OfflineConversionService {
-	return &OfflineConversionService{Auth: *auth}
+	return NewOfflineConversionFeedService(auth)
+}
+
+// MaxOfflineConversionsPerRequest is the number of conversions the api
+// accepts in a single mutate.
+const MaxOfflineConversionsPerRequest = 2000
+
+// OfflineConversionFeed is a conversion of a click, imported from outside
+// of adwords.
+//
+// ConversionName: name of an UploadConversion conversion tracker
+// ConversionTime: "20060102 150405 Zone", after the click, see
+// FormatConversionTime
+// ConversionCurrencyCode: ISO 4217 code, the one of the account if empty
+// ExternalAttributionCredit: share of the conversion credited to the
+// click, between 0 and 1, for conversions attributed outside of adwords
+type OfflineConversionFeed struct {
+	GoogleClickId             string   `xml:"googleClickId"`
+	ConversionName            string   `xml:"conversionName"`
+	ConversionTime            string   `xml:"conversionTime"`
+	ConversionValue           float64  `xml:"conversionValue,omitempty"`
+	ConversionCurrencyCode    string   `xml:"conversionCurrencyCode,omitempty"`
+	ExternalAttributionCredit *float64 `xml:"externalAttributionCredit,omitempty"`
+	ExternalAttributionModel  string   `xml:"externalAttributionModel,omitempty"`
+}
+
+// OfflineConversionFeedOperations is a map of operations to perform on
+// OfflineConversionFeed's, they can only be added.
+type OfflineConversionFeedOperations map[string][]OfflineConversionFeed
+
+// FormatConversionTime returns t in the format of the conversion times,
+// see FormatDateTime.
+func FormatConversionTime(t time.Time) string {
+	return FormatDateTime(t)
+}
+
+var conversionTimeFormat = regexp.MustCompile(`^(\d{8} \d{6}) [^ ]+$`)
+
+// validateConversionTime checks the format of a conversion time, the zone
+// is left to the api.
+func validateConversionTime(conversionTime string) error {
+	match := conversionTimeFormat.FindStringSubmatch(conversionTime)
+	if match == nil {
+		return fmt.Errorf("invalid conversion time %q, expected \"yyyyMMdd HHmmss zone\"", conversionTime)
+	}
+	if _, err := time.Parse("20060102 150405", match[1]); err != nil {
+		return fmt.Errorf("invalid conversion time %q, %s", conversionTime, err)
+	}
+	return nil
+}
+
+// Mutate uploads offline conversions, returning the conversions imported.
+//
+// Example
+//
+//   conversions, err := offlineConversionFeedService.Mutate(
+//     gads.OfflineConversionFeedOperations{
+//       "ADD": {
+//         gads.OfflineConversionFeed{
+//           GoogleClickId:          gclid,
+//           ConversionName:         "crm sale",
+//           ConversionTime:         gads.FormatConversionTime(saleTime),
+//           ConversionValue:        149.9,
+//           ConversionCurrencyCode: "EUR",
+//         },
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/OfflineConversionFeedService#mutate
+//
+func (s *OfflineConversionFeedService) Mutate(conversionOperations OfflineConversionFeedOperations) (conversions []OfflineConversionFeed, err error) {
+	type offlineConversionFeedOperation struct {
+		Action     string                `xml:"operator"`
+		Conversion OfflineConversionFeed `xml:"operand"`
+	}
+	operations := []offlineConversionFeedOperation{}
+	for action, conversions := range conversionOperations {
+		for _, conversion := range conversions {
+			operations = append(operations,
+				offlineConversionFeedOperation{
+					Action:     action,
+					Conversion: conversion,
+				},
+			)
+		}
+	}
+	mutation := struct {
+		XMLName xml.Name
+		Ops     []offlineConversionFeedOperation `xml:"operations"`
+	}{
+		XMLName: xml.Name{
+			Space: s.Auth.namespace(offlineConversionFeedServiceUrl),
+			Local: "mutate",
+		},
+		Ops: operations,
+	}
+	respBody, err := s.Auth.request(offlineConversionFeedServiceUrl, "mutate", mutation)
+	if err != nil {
+		return conversions, err
+	}
+	mutateResp := struct {
+		BaseResponse
+		Conversions []OfflineConversionFeed `xml:"rval>value"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &mutateResp)
+	if err != nil {
+		return conversions, err
+	}
+
+	if len(mutateResp.PartialFailureErrors) > 0 {
+		err = mutateResp.PartialFailureErrors
+	}
+
+	return mutateResp.Conversions, err
+}
+
+// OfflineConversionRowError is a row of a csv file rejected, before the
+// upload or by the api.
+type OfflineConversionRowError struct {
+	Line int // in the file, the header being the line 1
+	Err  error
+}
+
+func (e OfflineConversionRowError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+}
+
+// offlineConversionColumns are the headers of the columns of the csv files
+// of conversions, as in the template of the adwords interface, and whether
+// they are required
+var offlineConversionColumns = []struct {
+	header   string
+	required bool
+}{
+	{"google click id", true},
+	{"conversion name", true},
+	{"conversion time", true},
+	{"conversion value", false},
+	{"conversion currency", false},
+	{"attributed credit", false},
+	{"attribution model", false},
+}
+
+// UploadCSV uploads the conversions of a csv file in batches of batchSize,
+// MaxOfflineConversionsPerRequest if zero. The header of the file names
+// the columns, as in the template of the adwords interface:
+//
+//   Google Click ID,Conversion Name,Conversion Time,Conversion Value,Conversion Currency
+//   Cj0KCQjw...,crm sale,20180701 153000 Europe/Paris,149.9,EUR
+//
+// "Attributed Credit" and "Attribution Model" columns can be added for the
+// conversions attributed outside of adwords.
+//
+// The rows are validated before the upload and sent with partial failure,
+// so that the invalid ones are reported in rowErrors without failing the
+// others. err reports the failures of the whole file or of a request.
+func (s *OfflineConversionFeedService) UploadCSV(r io.Reader, batchSize int) (uploaded int, rowErrors []OfflineConversionRowError, err error) {
+	if batchSize <= 0 || batchSize > MaxOfflineConversionsPerRequest {
+		batchSize = MaxOfflineConversionsPerRequest
+	}
+	conversions, lines, rowErrors, err := readOfflineConversionCSV(r)
+	if err != nil {
+		return uploaded, rowErrors, err
+	}
+
+	service := *s
+	service.Auth.PartialFailure = true
+	uploaded, err = mutateInBatches(len(conversions), batchSize,
+		func(start, end int) error {
+			_, err := service.Mutate(OfflineConversionFeedOperations{"ADD": conversions[start:end]})
+			return err
+		},
+		func(index int, err error) {
+			rowErrors = append(rowErrors, OfflineConversionRowError{Line: lines[index], Err: err})
+		},
+	)
+	return uploaded, rowErrors, err
+}
+
+// readOfflineConversionCSV returns the valid conversions of the csv file
+// and their lines, and the errors of the invalid ones
+func readOfflineConversionCSV(r io.Reader) (conversions []OfflineConversionFeed, lines []int, rowErrors []OfflineConversionRowError, err error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return conversions, lines, rowErrors, fmt.Errorf("invalid conversions header, %s", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, column := range offlineConversionColumns {
+		if _, ok := columns[column.header]; column.required && !ok {
+			return conversions, lines, rowErrors, fmt.Errorf("missing conversions column %q", column.header)
+		}
+	}
+
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return conversions, lines, rowErrors, nil
+		}
+		if err != nil {
+			if _, ok := err.(*csv.ParseError); !ok {
+				return conversions, lines, rowErrors, err
+			}
+			rowErrors = append(rowErrors, OfflineConversionRowError{Line: line, Err: err})
+			continue
+		}
+		value := func(header string) string {
+			if i, ok := columns[header]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		conversion, err := parseOfflineConversion(value)
+		if err != nil {
+			rowErrors = append(rowErrors, OfflineConversionRowError{Line: line, Err: err})
+			continue
+		}
+		conversions = append(conversions, conversion)
+		lines = append(lines, line)
+	}
+}
+
+// parseOfflineConversion returns the conversion of a row whose columns are
+// returned by value
+func parseOfflineConversion(value func(header string) string) (conversion OfflineConversionFeed, err error) {
+	conversion = OfflineConversionFeed{
+		GoogleClickId:            value("google click id"),
+		ConversionName:           value("conversion name"),
+		ConversionTime:           value("conversion time"),
+		ConversionCurrencyCode:   value("conversion currency"),
+		ExternalAttributionModel: value("attribution model"),
+	}
+	if conversion.GoogleClickId == "" {
+		return conversion, fmt.Errorf("missing google click id")
+	}
+	if conversion.ConversionName == "" {
+		return conversion, fmt.Errorf("missing conversion name")
+	}
+	if err := validateConversionTime(conversion.ConversionTime); err != nil {
+		return conversion, err
+	}
+	if v := value("conversion value"); v != "" {
+		conversion.ConversionValue, err = strconv.ParseFloat(v, 64)
+		if err != nil || conversion.ConversionValue < 0 {
+			return conversion, fmt.Errorf("invalid conversion value %q", v)
+		}
+	}
+	if v := value("attributed credit"); v != "" {
+		credit, err := strconv.ParseFloat(v, 64)
+		if err != nil || credit <= 0 || credit > 1 {
+			return conversion, fmt.Errorf("invalid attributed credit %q, expected a number between 0 and 1", v)
+		}
+		conversion.ExternalAttributionCredit = &credit
+	}
+	if (conversion.ExternalAttributionCredit == nil) != (conversion.ExternalAttributionModel == "") {
+		return conversion, fmt.Errorf("attributed credit and attribution model go together")
+	}
+	return conversion, nil
 }
@@ -0,0 +1,82 @@
+package gads
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testOfflineConversionPartialFailure = `
+        <partialFailureErrors xsi:type="OfflineConversionError">
+          <fieldPath>operations[1].operand.googleClickId</fieldPath>
+          <trigger>unknown</trigger>
+          <errorString>OfflineConversionError.UNPARSEABLE_GCLID</errorString>
+          <reason>UNPARSEABLE_GCLID</reason>
+        </partialFailureErrors>
+        <value><googleClickId>gclid-1</googleClickId></value>
+        <value/>`
+
+const testOfflineConversionCSV = `Google Click ID,Conversion Name,Conversion Time,Conversion Value,Conversion Currency,Attributed Credit,Attribution Model
+gclid-1,crm sale,20180701 153000 Europe/Paris,149.9,EUR,,
+gclid-2,crm sale,2018-07-01 15:30:00,149.9,EUR,,
+gclid-3,crm sale,20180701 153000 Europe/Paris,cheap,EUR,,
+unknown,crm sale,20180701 153000 Europe/Paris,10,,,
+gclid-4,crm lead,20180702 090000 America/New_York,,,0.5,external model
+gclid-5,crm lead,20180702 090000 America/New_York,,,0.5,
+`
+
+func TestOfflineConversionUploadCSV(t *testing.T) {
+	var requests []testRequest
+	auth, cleanup := testServiceAuth(t, &requests, func(request testRequest) string {
+		if len(requests) == 1 {
+			return testOfflineConversionPartialFailure
+		}
+		return "<value><googleClickId>gclid-4</googleClickId></value>"
+	})
+	defer cleanup()
+
+	service := NewOfflineConversionFeedService(&auth)
+	uploaded, rowErrors, err := service.UploadCSV(strings.NewReader(testOfflineConversionCSV), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uploaded != 2 {
+		t.Errorf("expected 2 conversions uploaded, got %d", uploaded)
+	}
+	lines := []int{}
+	for _, rowError := range rowErrors {
+		lines = append(lines, rowError.Line)
+	}
+	if !reflect.DeepEqual(lines, []int{3, 4, 7, 5}) {
+		t.Errorf("unexpected row errors %v", rowErrors)
+	}
+	if !strings.Contains(rowErrors[3].Error(), "UNPARSEABLE_GCLID") {
+		t.Errorf("expected the api error to be reported, got %s", rowErrors[3])
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 batches, got %d", len(requests))
+	}
+	for _, expected := range []string{
+		"<partialFailure>true</partialFailure>",
+		"<googleClickId>gclid-1</googleClickId>",
+		"<conversionTime>20180701 153000 Europe/Paris</conversionTime>",
+		"<conversionValue>149.9</conversionValue>",
+		"<googleClickId>unknown</googleClickId>",
+	} {
+		if !strings.Contains(requests[0].Body, expected) {
+			t.Errorf("expected %s in the first batch\n%s", expected, requests[0].Body)
+		}
+	}
+	if !strings.Contains(requests[1].Body, "<externalAttributionCredit>0.5</externalAttributionCredit>") ||
+		strings.Contains(requests[1].Body, "conversionValue") {
+		t.Errorf("unexpected second batch\n%s", requests[1].Body)
+	}
+	if auth.PartialFailure || service.Auth.PartialFailure {
+		t.Error("expected the partial failure to be set for the upload only")
+	}
+
+	if _, _, err := service.UploadCSV(strings.NewReader("Google Click ID,Conversion Time\n"), 0); err == nil {
+		t.Error("expected a file without conversion name to be rejected")
+	}
+}
@@ -0,0 +1,175 @@
+package gads
+
+import "fmt"
+
+// DefaultPageSize is the number of entities fetched per request by a Pager
+// whose selector has no paging.
+const DefaultPageSize = 500
+
+// Pager iterates over all the entities matching a selector or an awql
+// query, fetching them one page at a time.
+//
+// Example
+//
+//   campaigns := cs.All(gads.Selector{Fields: []string{"Id", "Name"}})
+//   defer campaigns.Close()
+//   for campaigns.Next() {
+//     campaign := campaigns.Value()
+//   }
+//   if err := campaigns.Err(); err != nil {
+//     log.Fatal(err)
+//   }
+//
+type Pager[T any] struct {
+	// PageSize is the number of entities fetched per request. The limit of
+	// the selector paging is used if zero, DefaultPageSize if none.
+	PageSize int64
+
+	// Prefetch makes the pager fetch the next page in the background while
+	// the current one is iterated.
+	Prefetch bool
+
+	get    func(Paging) ([]T, int64, error)
+	paging *Paging // first page, as set on the selector
+
+	started    bool
+	offset     int64 // of the next page
+	totalCount int64
+	page       []T
+	index      int
+	last       bool // no page left to fetch
+	closed     bool
+	err        error
+	prefetched chan pagerPage[T]
+}
+
+type pagerPage[T any] struct {
+	entities   []T
+	totalCount int64
+	err        error
+}
+
+// NewPager returns a Pager over the entities returned by get for selector.
+// get is usually the Get method of a service, as
+//
+//   gads.NewPager(cs.Get, selector)
+//
+func NewPager[S ~[]T, T any](get func(Selector) (S, int64, error), selector Selector) *Pager[T] {
+	return &Pager[T]{
+		get: func(paging Paging) ([]T, int64, error) {
+			selector := selector
+			selector.Paging = &paging
+			entities, totalCount, err := get(selector)
+			return entities, totalCount, err
+		},
+		paging: selector.Paging,
+		index:  -1,
+	}
+}
+
+// NewQueryPager returns a Pager over the entities returned by query for the
+// awql query, which must not have a LIMIT clause. query is usually the Query
+// method of a service, as
+//
+//   gads.NewQueryPager(cs.Query, "SELECT Id, Name WHERE Status = 'ENABLED' ORDER BY Name")
+//
+func NewQueryPager[S ~[]T, T any](query func(string) (S, int64, error), awql string) *Pager[T] {
+	return &Pager[T]{
+		get: func(paging Paging) ([]T, int64, error) {
+			entities, totalCount, err := query(fmt.Sprintf("%s LIMIT %d,%d", awql, paging.Offset, paging.Limit))
+			return entities, totalCount, err
+		},
+		index: -1,
+	}
+}
+
+// Next moves to the next entity, fetching the next page if needed. It
+// returns false once all the entities are iterated, an error occurred or
+// the pager was closed.
+func (p *Pager[T]) Next() bool {
+	if p.err != nil || p.closed {
+		return false
+	}
+	p.index++
+	for p.index >= len(p.page) {
+		if p.last {
+			return false
+		}
+		if !p.fetch() {
+			return false
+		}
+	}
+	return true
+}
+
+// Value returns the current entity
+func (p *Pager[T]) Value() T {
+	return p.page[p.index]
+}
+
+// Err returns the error met fetching a page, if any
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// TotalCount returns the number of entities matching the selector or the
+// query, as reported with the last page fetched.
+func (p *Pager[T]) TotalCount() int64 {
+	return p.totalCount
+}
+
+// Close stops the iteration, no more page is fetched. A page being
+// prefetched is discarded.
+func (p *Pager[T]) Close() {
+	p.closed = true
+	p.page = nil
+}
+
+// fetch moves to the next page, returns false on error
+func (p *Pager[T]) fetch() bool {
+	if !p.started {
+		p.started = true
+		if p.paging != nil {
+			p.offset = p.paging.Offset
+			if p.PageSize == 0 {
+				p.PageSize = p.paging.Limit
+			}
+		}
+		if p.PageSize == 0 {
+			p.PageSize = DefaultPageSize
+		}
+	}
+
+	var page pagerPage[T]
+	if p.prefetched != nil {
+		page = <-p.prefetched
+		p.prefetched = nil
+	} else {
+		page = fetchPage(p.get, p.nextPaging())
+	}
+	if page.err != nil {
+		p.err = page.err
+		return false
+	}
+
+	p.page, p.index, p.totalCount = page.entities, 0, page.totalCount
+	p.offset += p.PageSize
+	p.last = len(page.entities) == 0 || p.offset >= p.totalCount
+	if p.Prefetch && !p.last {
+		p.prefetched = make(chan pagerPage[T], 1)
+		go func(prefetched chan<- pagerPage[T], get func(Paging) ([]T, int64, error), paging Paging) {
+			prefetched <- fetchPage(get, paging)
+		}(p.prefetched, p.get, p.nextPaging())
+	}
+	return true
+}
+
+// nextPaging returns the paging of the next page
+func (p *Pager[T]) nextPaging() Paging {
+	return Paging{Offset: p.offset, Limit: p.PageSize}
+}
+
+func fetchPage[T any](get func(Paging) ([]T, int64, error), paging Paging) pagerPage[T] {
+	entities, totalCount, err := get(paging)
+	return pagerPage[T]{entities: entities, totalCount: totalCount, err: err}
+}
@@ -0,0 +1,109 @@
+package gads
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// testPages returns a get function over totalCount numbers, recording the
+// offsets requested
+func testPages(totalCount int64, offsets *[]int64) func(Selector) ([]int64, int64, error) {
+	return func(selector Selector) (entities []int64, _ int64, err error) {
+		*offsets = append(*offsets, selector.Paging.Offset)
+		for i := selector.Paging.Offset; i < totalCount && i < selector.Paging.Offset+selector.Paging.Limit; i++ {
+			entities = append(entities, i)
+		}
+		return entities, totalCount, nil
+	}
+}
+
+func TestPager(t *testing.T) {
+	for _, test := range []struct {
+		totalCount, pageSize int64
+		prefetch             bool
+		offsets              []int64
+	}{
+		{totalCount: 0, pageSize: 2, offsets: []int64{0}},
+		{totalCount: 3, pageSize: 2, offsets: []int64{0, 2}},
+		{totalCount: 4, pageSize: 2, offsets: []int64{0, 2}}, // no request for an empty last page
+		{totalCount: 4, pageSize: 2, prefetch: true, offsets: []int64{0, 2}},
+		{totalCount: 5, pageSize: 0, offsets: []int64{0}},
+	} {
+		offsets := []int64{}
+		pager := NewPager(testPages(test.totalCount, &offsets), Selector{})
+		pager.PageSize, pager.Prefetch = test.pageSize, test.prefetch
+		var count int64
+		for pager.Next() {
+			if pager.Value() != count {
+				t.Errorf("expected %d, got %d", count, pager.Value())
+			}
+			count++
+		}
+		if pager.Err() != nil {
+			t.Fatal(pager.Err())
+		}
+		if count != test.totalCount || pager.TotalCount() != test.totalCount {
+			t.Errorf("expected %d entities, got %d", test.totalCount, count)
+		}
+		if !reflect.DeepEqual(offsets, test.offsets) {
+			t.Errorf("%+v: expected the offsets %v, got %v", test, test.offsets, offsets)
+		}
+	}
+}
+
+func TestPagerSelectorPaging(t *testing.T) {
+	offsets := []int64{}
+	selector := Selector{Paging: &Paging{Offset: 3, Limit: 3}}
+	pager := NewPager(testPages(10, &offsets), selector)
+	for pager.Next() {
+		if pager.Value() == 7 {
+			pager.Close()
+		}
+	}
+	if !reflect.DeepEqual(offsets, []int64{3, 6}) {
+		t.Errorf("expected the offsets [3 6], got %v", offsets)
+	}
+	if selector.Paging.Offset != 3 {
+		t.Errorf("the selector must not be modified")
+	}
+}
+
+func TestPagerError(t *testing.T) {
+	fault := errors.New("fault")
+	calls := 0
+	pager := NewPager(func(selector Selector) ([]int64, int64, error) {
+		calls++
+		if calls == 2 {
+			return nil, 0, fault
+		}
+		return []int64{1}, 3, nil
+	}, Selector{})
+	pager.PageSize = 1
+	count := 0
+	for pager.Next() {
+		count++
+	}
+	if count != 1 || pager.Err() != fault {
+		t.Errorf("expected 1 entity and an error, got %d and %v", count, pager.Err())
+	}
+	if pager.Next() || calls != 2 {
+		t.Errorf("the pager must stop on error")
+	}
+}
+
+func TestQueryPager(t *testing.T) {
+	queries := []string{}
+	pager := NewQueryPager(func(query string) ([]string, int64, error) {
+		queries = append(queries, query)
+		return []string{query}, 3, nil
+	}, "SELECT Id")
+	pager.PageSize = 2
+	for pager.Next() {
+	}
+	expected := []string{"SELECT Id LIMIT 0,2", "SELECT Id LIMIT 2,2"}
+	if fmt.Sprint(queries) != fmt.Sprint(expected) {
+		t.Errorf("expected the queries %q, got %q", expected, queries)
+	}
+}
@@ -0,0 +1,442 @@
+package gads
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ReportDecoder reads the rows of a report downloaded with the
+// ReportDefinitionService or the AWQLClient one at a time, without loading
+// the whole report in memory. The CSV, TSV and XML formats are read, as is
+// or gzipped. The rows of the report header, column header and summary of
+// the CSV and TSV reports are told apart from the values by the
+// ReportLayout of the download, see ReportDefinition.Layout and
+// AWQLRequest.Layout.
+//
+// The rows are decoded into a map[string]string of the values by column,
+// or into a struct whose fields are matched with the columns, by their
+// name or their report tag, compared without case to the column header of
+// the CSV and TSV reports, the display name or the xml name of the column
+// of the XML reports:
+//
+//   type campaignRow struct {
+//     CampaignId int64     `report:"Campaign ID"`
+//     Day        time.Time `report:"Day"`
+//     Clicks     int64
+//     Cost       float64   `report:"Cost,micros"`
+//     Ctr        float64   `report:"CTR"`
+//     Ignored    string    `report:"-"`
+//   }
+//
+// The values are converted to the type of the field:
+//
+//   - "--" and " --" are null, leaving the field to its zero value and its
+//     pointer to nil
+//   - the integers and decimals may carry thousand separators
+//   - the micro amounts of the fields tagged with the micros option are
+//     divided by a million, such as the costs and bids into float64
+//   - the percentages are divided by 100 into decimals, "12.34%" being
+//     0.1234, and the bounds "< 10%" and "> 90%" being 0.1 and 0.9
+//   - the days are parsed as "2006-01-02" into time.Time
+//   - the types implementing encoding.TextUnmarshaler decode themselves
+//
+// Example
+//
+//   body, err := reportDefinitionService.Request(&definition)
+//   if err != nil {
+//     return err
+//   }
+//   defer body.Close()
+//   decoder, err := gads.NewReportDecoder(body, definition.DownloadFormat, definition.Layout())
+//   if err != nil {
+//     return err
+//   }
+//   for {
+//     var row campaignRow
+//     if err := decoder.Decode(&row); err == io.EOF {
+//       break
+//     } else if err != nil {
+//       return err
+//     }
+//     ...
+//   }
+//
+type ReportDecoder struct {
+	next    func() ([]string, error)
+	columns []reportColumn
+	row     int
+	summary map[string]string
+	fields  map[reflect.Type][]reportField
+}
+
+// reportColumn is the column header of a CSV or TSV report, or the xml
+// and display names of a column of an XML report
+type reportColumn struct {
+	name    string
+	display string
+}
+
+// reportField is the field of a struct decoded from a column
+type reportField struct {
+	column int
+	index  []int
+	micros bool
+}
+
+// ReportLayout tells which rows of a CSV or TSV report surround its values,
+// as requested with the skip headers of its download. Columns lists the
+// columns of the reports without column header, in the order of the
+// values.
+type ReportLayout struct {
+	SkipReportHeader  bool
+	SkipColumnHeader  bool
+	SkipReportSummary bool
+	Columns           []string
+}
+
+// Layout returns the layout of the reports downloaded with the definition.
+func (r *ReportDefinition) Layout() ReportLayout {
+	return ReportLayout{SkipReportHeader: r.SkipHeader, SkipReportSummary: r.SkipSummary}
+}
+
+// awqlSelect matches the fields selected by an awql query
+var awqlSelect = regexp.MustCompile(`(?is)^\s*SELECT\s+(.+?)\s+FROM\s`)
+
+// Layout returns the layout of the reports downloaded with the request,
+// the columns being the fields selected by the query.
+func (r AWQLRequest) Layout() ReportLayout {
+	layout := ReportLayout{
+		SkipReportHeader:  r.SkipReportHeader,
+		SkipColumnHeader:  r.SkipColumnHeader,
+		SkipReportSummary: r.SkipReportSummary,
+	}
+	if match := awqlSelect.FindStringSubmatch(r.Query); match != nil {
+		for _, field := range strings.Split(match[1], ",") {
+			layout.Columns = append(layout.Columns, strings.TrimSpace(field))
+		}
+	}
+	return layout
+}
+
+// NewReportDecoder returns a decoder of the rows of the report r in format,
+// an AWQLFormat being converted with DownloadFormat(awqlFormat), laid out
+// as requested with its download. The gzipped reports are detected
+// whatever the format.
+func NewReportDecoder(r io.Reader, format DownloadFormat, layout ReportLayout) (*ReportDecoder, error) {
+	buffered := bufio.NewReader(r)
+	if magic, err := buffered.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gzipReader, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, err
+		}
+		r = gzipReader
+	} else {
+		r = buffered
+	}
+
+	d := &ReportDecoder{fields: map[reflect.Type][]reportField{}}
+	switch format {
+	case DownloadFormatCSV, DownloadFormatCSVGzipped, DownloadFormatTSV:
+		if layout.SkipColumnHeader && len(layout.Columns) == 0 {
+			return nil, fmt.Errorf("the columns of a report without column header are required")
+		}
+		comma := ','
+		if format == DownloadFormatTSV {
+			comma = '\t'
+		}
+		d.readCSV(r, comma, layout)
+	case DownloadFormatXML, DownloadFormatXMLGzipped:
+		d.readXML(r)
+	default:
+		return nil, fmt.Errorf("unsupported report format %s", format)
+	}
+	return d, nil
+}
+
+// readCSV reads the rows of a CSV or TSV report, the first read skipping
+// the report header and reading the column header as laid out. The last
+// row is the summary unless skipped, which requires reading one row ahead.
+func (d *ReportDecoder) readCSV(r io.Reader, comma rune, layout ReportLayout) {
+	reader := csv.NewReader(r)
+	reader.Comma = comma
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	var ahead []string
+	var aheadErr error
+	started := false
+	d.next = func() ([]string, error) {
+		if !started {
+			started = true
+			if !layout.SkipReportHeader {
+				if _, err := reader.Read(); err != nil {
+					return nil, err
+				}
+			}
+			columns := layout.Columns
+			if !layout.SkipColumnHeader {
+				record, err := reader.Read()
+				if err != nil {
+					return nil, err
+				}
+				columns = record
+			}
+			d.columns = make([]reportColumn, len(columns))
+			for i, header := range columns {
+				d.columns[i] = reportColumn{name: header, display: header}
+			}
+			ahead, aheadErr = reader.Read()
+		}
+		for {
+			record, err := ahead, aheadErr
+			if err != nil {
+				return nil, err
+			}
+			ahead, aheadErr = reader.Read()
+			if !layout.SkipReportSummary && aheadErr == io.EOF {
+				d.summary = d.values(record)
+				continue
+			}
+			if len(record) != len(d.columns) {
+				return nil, fmt.Errorf("row %d: %d values for %d columns", d.row+1, len(record), len(d.columns))
+			}
+			return record, nil
+		}
+	}
+}
+
+// readXML reads the rows of an XML report, the columns being read before
+// the first row
+func (d *ReportDecoder) readXML(r io.Reader) {
+	decoder := xml.NewDecoder(r)
+	indexes := map[string]int{}
+	d.next = func() ([]string, error) {
+		for {
+			token, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			start, ok := token.(xml.StartElement)
+			if !ok {
+				continue
+			}
+			switch start.Name.Local {
+			case "column":
+				column := reportColumn{}
+				for _, attr := range start.Attr {
+					switch attr.Name.Local {
+					case "name":
+						column.name = attr.Value
+					case "display":
+						column.display = attr.Value
+					}
+				}
+				if column.display == "" {
+					column.display = column.name
+				}
+				indexes[column.name] = len(d.columns)
+				d.columns = append(d.columns, column)
+			case "row":
+				record := make([]string, len(d.columns))
+				for i := range record {
+					record[i] = "--"
+				}
+				for _, attr := range start.Attr {
+					if i, ok := indexes[attr.Name.Local]; ok {
+						record[i] = attr.Value
+					}
+				}
+				return record, nil
+			}
+		}
+	}
+}
+
+// Columns returns the display names of the columns of the report, known
+// once the first row is decoded.
+func (d *ReportDecoder) Columns() []string {
+	columns := make([]string, len(d.columns))
+	for i, column := range d.columns {
+		columns[i] = column.display
+	}
+	return columns
+}
+
+// Summary returns the values of the summary row of a CSV or TSV report by
+// column, known once Decode returned io.EOF, nil without summary.
+func (d *ReportDecoder) Summary() map[string]string {
+	return d.summary
+}
+
+// values returns the values of a record by column display name
+func (d *ReportDecoder) values(record []string) map[string]string {
+	values := map[string]string{}
+	for i, column := range d.columns {
+		if i < len(record) {
+			values[column.display] = record[i]
+		}
+	}
+	return values
+}
+
+// Decode decodes the next row of the report into v, a pointer to a struct
+// or to a map[string]string, and returns io.EOF after the last row.
+func (d *ReportDecoder) Decode(v interface{}) error {
+	record, err := d.next()
+	if err != nil {
+		return err
+	}
+	d.row++
+
+	if values, ok := v.(*map[string]string); ok {
+		*values = d.values(record)
+		return nil
+	}
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("report rows are decoded into a pointer to a struct or a map[string]string, not %T", v)
+	}
+	value = value.Elem()
+	fields, err := d.structFields(value.Type())
+	if err != nil {
+		return err
+	}
+	for _, field := range fields {
+		raw := record[field.column]
+		if err := setReportValue(value.FieldByIndex(field.index), raw, field.micros); err != nil {
+			return fmt.Errorf("row %d, column %s: invalid value %q, %s", d.row, d.columns[field.column].display, raw, err)
+		}
+	}
+	return nil
+}
+
+// structFields returns the fields of the struct type matching the columns,
+// an error if none does, such as with a report laid out differently.
+func (d *ReportDecoder) structFields(t reflect.Type) ([]reportField, error) {
+	if fields, ok := d.fields[t]; ok {
+		return fields, nil
+	}
+	fields := []reportField{}
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		if structField.PkgPath != "" {
+			continue
+		}
+		name, micros := structField.Name, false
+		if tag, ok := structField.Tag.Lookup("report"); ok {
+			parts := strings.Split(tag, ",")
+			if parts[0] == "-" {
+				continue
+			}
+			if parts[0] != "" {
+				name = parts[0]
+			}
+			for _, option := range parts[1:] {
+				micros = micros || option == "micros"
+			}
+		}
+		for column, header := range d.columns {
+			if strings.EqualFold(name, header.display) || strings.EqualFold(name, header.name) {
+				fields = append(fields, reportField{column: column, index: structField.Index, micros: micros})
+				break
+			}
+		}
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no field of %s matches the columns %s of the report", t, strings.Join(d.Columns(), ", "))
+	}
+	d.fields[t] = fields
+	return fields, nil
+}
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// setReportValue converts the raw value of a report into the field
+func setReportValue(field reflect.Value, raw string, micros bool) error {
+	if strings.TrimSpace(raw) == "--" {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+	if field.Kind() == reflect.Ptr {
+		value := reflect.New(field.Type().Elem())
+		if err := setReportValue(value.Elem(), raw, micros); err != nil {
+			return err
+		}
+		field.Set(value)
+		return nil
+	}
+	if field.Type() == reflect.TypeOf(time.Time{}) {
+		t, err := time.Parse("2006-01-02", raw)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(t))
+		return nil
+	}
+	if field.CanAddr() && field.Addr().Type().Implements(textUnmarshalerType) {
+		return field.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(raw))
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(strings.Replace(raw, ",", "", -1), 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(strings.Replace(raw, ",", "", -1), 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := parseReportFloat(raw)
+		if err != nil {
+			return err
+		}
+		if micros {
+			f /= 1000000
+		}
+		field.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+	return nil
+}
+
+// parseReportFloat parses a decimal, a percentage or a bound of a
+// percentage
+func parseReportFloat(raw string) (float64, error) {
+	value := strings.TrimSpace(raw)
+	value = strings.TrimSpace(strings.TrimLeft(value, "<>"))
+	value = strings.Replace(value, ",", "", -1)
+	percent := strings.HasSuffix(value, "%")
+	value = strings.TrimSuffix(value, "%")
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return f, err
+	}
+	if percent {
+		f /= 100
+	}
+	return f, nil
+}
@@ -0,0 +1,206 @@
+package gads
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testReportCSV = `"CAMPAIGN_PERFORMANCE_REPORT (Jul 1, 2018-Jul 2, 2018)"
+Campaign ID,Campaign,Day,Impressions,Clicks,Cost,CTR,Search Lost IS (rank),Avg. position
+1,"summer, sale",2018-07-01,"1,204",13,2340000,1.08%,< 10%,1.3
+2,brand,2018-07-02,0,0,0,0.00%, --,--
+Total,--,--,"1,204",13,2340000,1.08%, --,--
+`
+
+const testReportTSV = "CAMPAIGN_PERFORMANCE_REPORT (Jul 1, 2018-Jul 2, 2018)\n" +
+	"Campaign ID\tCampaign\tDay\tImpressions\tClicks\tCost\tCTR\tSearch Lost IS (rank)\tAvg. position\n" +
+	"1\tsummer, sale\t2018-07-01\t1,204\t13\t2340000\t1.08%\t< 10%\t1.3\n" +
+	"2\tbrand\t2018-07-02\t0\t0\t0\t0.00%\t --\t--\n" +
+	"Total\t--\t--\t1,204\t13\t2340000\t1.08%\t --\t--\n"
+
+const testReportXML = `<?xml version='1.0' encoding='UTF-8' standalone='yes'?>
+<report>
+  <report-name name='CAMPAIGN_PERFORMANCE_REPORT' />
+  <date-range date='Jul 1, 2018-Jul 2, 2018' />
+  <table>
+    <columns>
+      <column name='campaignID' display='Campaign ID' />
+      <column name='campaign' display='Campaign' />
+      <column name='day' display='Day' />
+      <column name='impressions' display='Impressions' />
+      <column name='clicks' display='Clicks' />
+      <column name='cost' display='Cost' />
+      <column name='ctr' display='CTR' />
+      <column name='searchRankLostImpressionShare' display='Search Lost IS (rank)' />
+      <column name='avgPosition' display='Avg. position' />
+    </columns>
+    <row campaignID='1' campaign='summer, sale' day='2018-07-01' impressions='1204' clicks='13' cost='2340000' ctr='1.08%' searchRankLostImpressionShare='&lt; 10%' avgPosition='1.3' />
+    <row campaignID='2' campaign='brand' day='2018-07-02' impressions='0' clicks='0' cost='0' ctr='0.00%' searchRankLostImpressionShare=' --' avgPosition='--' />
+  </table>
+</report>`
+
+type testReportRow struct {
+	CampaignId         int64 `report:"Campaign ID"`
+	Campaign           string
+	Day                time.Time
+	Impressions        int64
+	Clicks             int
+	Cost               float64  `report:"Cost,micros"`
+	CostMicros         int64    `report:"cost"`
+	Ctr                float64  `report:"CTR"`
+	SearchRankLostIS   *float64 `report:"Search Lost IS (rank)"`
+	AveragePosition    float64  `report:"Avg. position"`
+	ConversionsIgnored string   `report:"-"`
+	unexported         string
+}
+
+func testReportRows() []testReportRow {
+	lost := 0.1
+	return []testReportRow{
+		{
+			CampaignId:       1,
+			Campaign:         "summer, sale",
+			Day:              time.Date(2018, 7, 1, 0, 0, 0, 0, time.UTC),
+			Impressions:      1204,
+			Clicks:           13,
+			Cost:             2.34,
+			CostMicros:       2340000,
+			Ctr:              0.0108,
+			SearchRankLostIS: &lost,
+			AveragePosition:  1.3,
+		},
+		{
+			CampaignId: 2,
+			Campaign:   "brand",
+			Day:        time.Date(2018, 7, 2, 0, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+func testDecodeReport(t *testing.T, r io.Reader, format DownloadFormat) (rows []testReportRow, decoder *ReportDecoder) {
+	decoder, err := NewReportDecoder(r, format, ReportLayout{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for {
+		var row testReportRow
+		err := decoder.Decode(&row)
+		if err == io.EOF {
+			return rows, decoder
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		rows = append(rows, row)
+	}
+}
+
+func TestReportDecoder(t *testing.T) {
+	gzipped := &bytes.Buffer{}
+	writer := gzip.NewWriter(gzipped)
+	writer.Write([]byte(testReportCSV))
+	writer.Close()
+
+	for name, c := range map[string]struct {
+		r      io.Reader
+		format DownloadFormat
+	}{
+		"csv":         {strings.NewReader(testReportCSV), DownloadFormatCSV},
+		"gzipped csv": {gzipped, DownloadFormatCSVGzipped},
+		"tsv":         {strings.NewReader(testReportTSV), DownloadFormatTSV},
+		"xml":         {strings.NewReader(testReportXML), DownloadFormatXML},
+	} {
+		rows, decoder := testDecodeReport(t, c.r, c.format)
+		if !reflect.DeepEqual(rows, testReportRows()) {
+			t.Errorf("%s: expected\n%#v\ngot\n%#v", name, testReportRows(), rows)
+		}
+		if columns := decoder.Columns(); len(columns) != 9 || columns[8] != "Avg. position" {
+			t.Errorf("%s: unexpected columns %v", name, columns)
+		}
+		if c.format != DownloadFormatXML && decoder.Summary()["Clicks"] != "13" {
+			t.Errorf("%s: unexpected summary %v", name, decoder.Summary())
+		}
+	}
+
+	decoder, err := NewReportDecoder(strings.NewReader(testReportCSV), DownloadFormatCSV, ReportLayout{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := map[string]string{}
+	if err := decoder.Decode(&values); err != nil {
+		t.Fatal(err)
+	}
+	if values["Campaign"] != "summer, sale" || values["Search Lost IS (rank)"] != "< 10%" {
+		t.Errorf("unexpected values %v", values)
+	}
+	var invalid struct {
+		Day int64
+	}
+	if err := decoder.Decode(&invalid); err == nil || !strings.Contains(err.Error(), "row 2, column Day") {
+		t.Errorf("expected the invalid day to be reported, got %v", err)
+	}
+}
+
+func TestReportDecoderLayout(t *testing.T) {
+	// a report whose first column is named like a report header and whose
+	// last row is not a summary
+	definition := ReportDefinition{SkipHeader: true, SkipSummary: true}
+	decoder, err := NewReportDecoder(strings.NewReader("Campaign (name)\nsummer\nTotal\n"), DownloadFormatCSV, definition.Layout())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for {
+		var row struct {
+			Name string `report:"Campaign (name)"`
+		}
+		if err := decoder.Decode(&row); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, row.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"summer", "Total"}) || decoder.Summary() != nil {
+		t.Errorf("unexpected rows %v and summary %v", names, decoder.Summary())
+	}
+
+	request := AWQLRequest{
+		Query:             "SELECT CampaignId, Clicks\nFROM CAMPAIGN_PERFORMANCE_REPORT DURING LAST_7_DAYS",
+		SkipReportHeader:  true,
+		SkipColumnHeader:  true,
+		SkipReportSummary: true,
+	}
+	decoder, err = NewReportDecoder(strings.NewReader("1\t13\n"), DownloadFormatTSV, request.Layout())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var row struct {
+		CampaignId int64
+		Clicks     int
+	}
+	if err := decoder.Decode(&row); err != nil || row.CampaignId != 1 || row.Clicks != 13 {
+		t.Errorf("unexpected row %#v, %v", row, err)
+	}
+
+	if _, err := NewReportDecoder(strings.NewReader("1,13\n"), DownloadFormatCSV, ReportLayout{SkipColumnHeader: true}); err == nil {
+		t.Error("expected the columns to be required without column header")
+	}
+
+	// the column header taken for the report header, matching no field
+	decoder, err = NewReportDecoder(strings.NewReader("Clicks\n13\n0\n"), DownloadFormatCSV, ReportLayout{SkipReportSummary: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var clicks struct {
+		Clicks int
+	}
+	if err := decoder.Decode(&clicks); err == nil || !strings.Contains(err.Error(), "no field") {
+		t.Errorf("expected a report laid out differently to be reported, got %v", err)
+	}
+}
@@ -90,7 +90,8 @@ type ReportDefinition struct {
 	SkipSummary            bool           `xml:"-"`
 }
 
-// ValidRequest returns an error if the report can't be used to do request to the api
+// ValidRequest returns an error if the report can't be used to do request to the api,
+// its fields being checked against the catalog of ReportFields
 func (r *ReportDefinition) ValidRequest() error {
 
 	if r == nil {
@@ -106,6 +107,9 @@ func (r *ReportDefinition) ValidRequest() error {
 	if err := r.DownloadFormat.Valid(); err != nil {
 		return err
 	}
+	if err := r.validFields(); err != nil {
+		return err
+	}
 
 	if r.Selector.DateRange != nil {
 		r.DateRangeType = DateRangeTypeCustom
@@ -114,6 +118,10 @@ func (r *ReportDefinition) ValidRequest() error {
 	return nil
 }
 
+// reportTimeout is the shortest timeout of the http client downloading the
+// reports, spec google, some reports can take up to 10 min to be downloaded
+const reportTimeout = 10 * time.Minute
+
 // ReportDefinitionService is the service you call when you want to access reports
 type ReportDefinitionService struct {
 	Auth
@@ -126,7 +134,8 @@ func NewReportDefinitionService(auth *Auth) *ReportDefinitionService {
 }
 
 // Request launch a request to the reporting api with the definition of the wanted report
-// We return a reader because the response format depends of the ReportDefinition.DownloadFormat field
+// We return a reader because the response format depends of the ReportDefinition.DownloadFormat field,
+// its rows can be read with NewReportDecoder
 func (r *ReportDefinitionService) Request(def *ReportDefinition) (body io.ReadCloser, err error) {
 
 	var req *http.Request
@@ -137,12 +146,12 @@ func (r *ReportDefinitionService) Request(def *ReportDefinition) (body io.ReadCl
 
 	var resp *http.Response
 
-	// spec google, some reports can take up to 10 min to be downloaded
-	if r.Auth.Client.Timeout < (10 * time.Minute) {
+	client := r.Auth.client()
+	if client.Timeout < reportTimeout {
 		return nil, errors.New("to fetch google reports, you need to set the http client timeout to 10 minute at last")
 	}
 
-	resp, err = r.Auth.Client.Do(req)
+	resp, err = client.Do(req)
 	if err != nil {
 		return
 	}
@@ -216,10 +225,11 @@ func (r *ReportDefinitionService) createHTTPRequest(def *ReportDefinition) (req
 	var f = url.Values{}
 	f.Set("__rdxml", string(b))
 
-	req, err = http.NewRequest("POST", reportAPIURL, strings.NewReader(f.Encode()))
+	req, err = http.NewRequest("POST", r.Auth.reportURL(), strings.NewReader(f.Encode()))
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(r.Auth.context())
 
 	req.Header.Add("clientCustomerId", cID)
 	req.Header.Add("developerToken", r.Auth.DeveloperToken)
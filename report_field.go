@@ -0,0 +1,161 @@
+package gads
+
+import (
+	_ "embed"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"sync"
+)
+
+//go:generate go run ./reportfields -o report_fields.json
+
+// ReportTypes lists the report types of the offline catalog of fields,
+// whose reports are checked by ValidRequest. go generate refreshes their
+// fields with those returned by the api, the reports of the other types
+// are sent unchecked.
+var ReportTypes = []string{"ACCOUNT_PERFORMANCE_REPORT", "CAMPAIGN_PERFORMANCE_REPORT"}
+
+// ReportDefinitionField is a field of a report type.
+//
+// FieldBehavior: ATTRIBUTE, METRIC, SEGMENT
+// ExclusiveFields: the fields which can not be selected with this one
+type ReportDefinitionField struct {
+	FieldName           string   `xml:"fieldName"`
+	DisplayFieldName    string   `xml:"displayFieldName"`
+	XmlAttributeName    string   `xml:"xmlAttributeName"`
+	FieldType           string   `xml:"fieldType"`
+	FieldBehavior       string   `xml:"fieldBehavior"`
+	EnumValues          []string `xml:"enumValues" json:",omitempty"`
+	CanSelect           bool     `xml:"canSelect"`
+	CanFilter           bool     `xml:"canFilter"`
+	IsEnumType          bool     `xml:"isEnumType"`
+	IsBeta              bool     `xml:"isBeta"`
+	IsZeroRowCompatible bool     `xml:"isZeroRowCompatible"`
+	ExclusiveFields     []string `xml:"exclusiveFields" json:",omitempty"`
+}
+
+// GetReportFields returns the fields of the report type.
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/ReportDefinitionService#getreportfields
+//
+func (r *ReportDefinitionService) GetReportFields(reportType string) (fields []ReportDefinitionField, err error) {
+	respBody, err := r.Auth.request(
+		reportDefinitionServiceUrl,
+		"getReportFields",
+		struct {
+			XMLName    xml.Name
+			ReportType string `xml:"reportType"`
+		}{
+			XMLName: xml.Name{
+				Space: r.Auth.namespace(reportDefinitionServiceUrl),
+				Local: "getReportFields",
+			},
+			ReportType: reportType,
+		},
+	)
+	if err != nil {
+		return fields, err
+	}
+	getResp := struct {
+		Fields []ReportDefinitionField `xml:"rval"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return fields, err
+	}
+	return getResp.Fields, err
+}
+
+// reportFieldsJSON is the offline catalog of the fields of ReportTypes. It
+// was compiled by hand from the v201806 reference of the reports, go
+// generate replaces it with the fields returned by the api.
+//
+//
+//go:embed report_fields.json
+var reportFieldsJSON []byte
+
+var (
+	reportFieldsOnce  sync.Once
+	reportFieldsMutex sync.RWMutex
+	reportFields      map[string][]ReportDefinitionField
+)
+
+// loadReportFields decodes the offline catalog on first use
+func loadReportFields() {
+	reportFieldsOnce.Do(func() {
+		reportFields = map[string][]ReportDefinitionField{}
+		if err := json.Unmarshal(reportFieldsJSON, &reportFields); err != nil {
+			panic(fmt.Sprintf("invalid report fields catalog, %s", err))
+		}
+	})
+}
+
+// ReportFields returns the fields of the report type in the catalog, and
+// whether the catalog knows the report type.
+func ReportFields(reportType string) ([]ReportDefinitionField, bool) {
+	loadReportFields()
+	reportFieldsMutex.RLock()
+	defer reportFieldsMutex.RUnlock()
+	fields, ok := reportFields[reportType]
+	return fields, ok
+}
+
+// SetReportFields replaces the fields of the report type in the catalog,
+// such as with those returned by GetReportFields for the api version of an
+// Auth. Without fields, the report type is dropped from the catalog and
+// its reports are no longer checked.
+func SetReportFields(reportType string, fields []ReportDefinitionField) {
+	loadReportFields()
+	reportFieldsMutex.Lock()
+	defer reportFieldsMutex.Unlock()
+	if len(fields) == 0 {
+		delete(reportFields, reportType)
+		return
+	}
+	reportFields[reportType] = fields
+}
+
+// validFields checks the fields selected and filtered on against the
+// catalog, the reports whose type is not in the catalog are not checked.
+func (r *ReportDefinition) validFields() error {
+	fields, ok := ReportFields(r.ReportType)
+	if !ok {
+		return nil
+	}
+	catalog := map[string]ReportDefinitionField{}
+	for _, field := range fields {
+		catalog[field.FieldName] = field
+	}
+
+	selected := map[string]bool{}
+	for _, name := range r.Selector.Fields {
+		field, ok := catalog[name]
+		if !ok {
+			return fmt.Errorf("unknown field %s in %s", name, r.ReportType)
+		}
+		if !field.CanSelect {
+			return fmt.Errorf("field %s of %s can not be selected", name, r.ReportType)
+		}
+		selected[name] = true
+	}
+	for _, name := range r.Selector.Fields {
+		for _, exclusive := range catalog[name].ExclusiveFields {
+			if selected[exclusive] {
+				return fmt.Errorf("fields %s and %s of %s can not be selected together", name, exclusive, r.ReportType)
+			}
+		}
+	}
+	for _, predicate := range r.Selector.Predicates {
+		field, ok := catalog[predicate.Field]
+		if !ok {
+			return fmt.Errorf("unknown field %s in %s", predicate.Field, r.ReportType)
+		}
+		if !field.CanFilter {
+			return fmt.Errorf("field %s of %s can not be filtered on", predicate.Field, r.ReportType)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package gads
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const testReportFieldsResponse = `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Body>
+    <getReportFieldsResponse xmlns="https://adwords.google.com/api/adwords/cm/v201806">
+      <rval>
+        <fieldName>CampaignId</fieldName>
+        <displayFieldName>Campaign ID</displayFieldName>
+        <xmlAttributeName>campaignID</xmlAttributeName>
+        <fieldType>Long</fieldType>
+        <fieldBehavior>ATTRIBUTE</fieldBehavior>
+        <canSelect>true</canSelect>
+        <canFilter>true</canFilter>
+        <isEnumType>false</isEnumType>
+        <isBeta>false</isBeta>
+        <isZeroRowCompatible>true</isZeroRowCompatible>
+      </rval>
+      <rval>
+        <fieldName>Device</fieldName>
+        <displayFieldName>Device</displayFieldName>
+        <xmlAttributeName>device</xmlAttributeName>
+        <fieldType>Device</fieldType>
+        <fieldBehavior>SEGMENT</fieldBehavior>
+        <enumValues>DESKTOP</enumValues>
+        <enumValues>HIGH_END_MOBILE</enumValues>
+        <canSelect>true</canSelect>
+        <canFilter>true</canFilter>
+        <isEnumType>true</isEnumType>
+        <isBeta>false</isBeta>
+        <isZeroRowCompatible>false</isZeroRowCompatible>
+        <exclusiveFields>Slot</exclusiveFields>
+      </rval>
+      <rval>
+        <fieldName>Slot</fieldName>
+        <fieldBehavior>SEGMENT</fieldBehavior>
+        <canSelect>true</canSelect>
+        <canFilter>true</canFilter>
+        <exclusiveFields>Device</exclusiveFields>
+      </rval>
+      <rval>
+        <fieldName>Impressions</fieldName>
+        <fieldBehavior>METRIC</fieldBehavior>
+        <canSelect>true</canSelect>
+        <canFilter>false</canFilter>
+      </rval>
+    </getReportFieldsResponse>
+  </soap:Body>
+</soap:Envelope>`
+
+func TestReportFields(t *testing.T) {
+	var body string
+	auth, cleanup := testServerAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		fmt.Fprint(w, testReportFieldsResponse)
+	})
+	defer cleanup()
+
+	fields, err := NewReportDefinitionService(&auth).GetReportFields("TEST_REPORT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(body, "<reportType>TEST_REPORT</reportType>") {
+		t.Errorf("unexpected request\n%s", body)
+	}
+	if len(fields) != 4 || fields[1].FieldName != "Device" || len(fields[1].EnumValues) != 2 ||
+		fields[1].ExclusiveFields[0] != "Slot" || !fields[0].CanFilter || fields[3].CanFilter {
+		t.Fatalf("unexpected fields %#v", fields)
+	}
+
+	SetReportFields("TEST_REPORT", fields)
+	defer SetReportFields("TEST_REPORT", nil)
+	for _, c := range []struct {
+		fields     []string
+		predicates []Predicate
+		err        string
+	}{
+		{fields: []string{"CampaignId", "Device", "Impressions"}},
+		{fields: []string{"CampaignId", "Clicks"}, err: "unknown field Clicks"},
+		{fields: []string{"Device", "Slot"}, err: "can not be selected together"},
+		{fields: []string{"CampaignId"}, predicates: []Predicate{{"Impressions", "GREATER_THAN", []string{"0"}}}, err: "can not be filtered on"},
+	} {
+		def := ReportDefinition{
+			ReportName:     "test",
+			ReportType:     "TEST_REPORT",
+			DownloadFormat: DownloadFormatCSV,
+			Selector:       Selector{Fields: c.fields, Predicates: c.predicates},
+		}
+		err := def.ValidRequest()
+		if (c.err == "" && err != nil) || (c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err))) {
+			t.Errorf("%v: expected the error %q, got %v", c.fields, c.err, err)
+		}
+	}
+
+	def := ReportDefinition{
+		ReportName:     "test",
+		ReportType:     "UNCHECKED_REPORT",
+		DownloadFormat: DownloadFormatCSV,
+		Selector:       Selector{Fields: []string{"Anything"}},
+	}
+	if err := def.ValidRequest(); err != nil {
+		t.Errorf("expected the reports out of the catalog not to be checked, got %s", err)
+	}
+}
+
+func TestReportFieldsCatalog(t *testing.T) {
+	fields, ok := ReportFields("CAMPAIGN_PERFORMANCE_REPORT")
+	if !ok || len(fields) == 0 {
+		t.Fatal("expected the campaign performance report in the embedded catalog")
+	}
+	def := ReportDefinition{
+		ReportName:     "campaigns",
+		ReportType:     "CAMPAIGN_PERFORMANCE_REPORT",
+		DownloadFormat: DownloadFormatCSV,
+		Selector:       Selector{Fields: []string{"CampaignId", "CampaignName", "Date", "Clicks", "Cost"}},
+	}
+	if err := def.ValidRequest(); err != nil {
+		t.Errorf("expected the campaign performance report to be valid, got %s", err)
+	}
+	def.Selector.Fields = append(def.Selector.Fields, "AdGroupName")
+	if err := def.ValidRequest(); err == nil || !strings.Contains(err.Error(), "unknown field AdGroupName") {
+		t.Errorf("expected the unknown field to be rejected, got %v", err)
+	}
+
+	// the conversions split by conversion name have no clicks
+	def.Selector.Fields = []string{"CampaignId", "ConversionTypeName", "Conversions"}
+	if err := def.ValidRequest(); err != nil {
+		t.Errorf("expected the conversions by name to be valid, got %s", err)
+	}
+	def.Selector.Fields = append(def.Selector.Fields, "Clicks")
+	if err := def.ValidRequest(); err == nil || !strings.Contains(err.Error(), "can not be selected together") {
+		t.Errorf("expected ConversionTypeName and Clicks to be rejected together, got %v", err)
+	}
+
+	for _, reportType := range ReportTypes {
+		if _, ok := ReportFields(reportType); !ok {
+			t.Errorf("expected %s in the embedded catalog", reportType)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+// Command reportfields writes the catalog of the fields of the report types
+// embedded in gads, with the fields returned by the api:
+//
+//   go generate github.com/querian/gads
+//
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"log"
+
+	"github.com/querian/gads"
+)
+
+var output = flag.String("o", "report_fields.json", "catalog file written")
+
+func main() {
+	flag.Parse()
+	config, err := gads.NewCredentials(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+	rds := gads.NewReportDefinitionService(&config.Auth)
+
+	catalog := map[string][]gads.ReportDefinitionField{}
+	for _, reportType := range gads.ReportTypes {
+		fields, err := rds.GetReportFields(reportType)
+		if err != nil {
+			log.Fatalf("%s: %s", reportType, err)
+		}
+		catalog[reportType] = fields
+	}
+	data, err := json.MarshalIndent(catalog, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*output, append(data, '\n'), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
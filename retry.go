@@ -0,0 +1,137 @@
+package gads
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy describes how the requests failing with a retryable error
+// are sent again. The errors considered as retryable are
+//
+//   RateExceededError, the server provided delay is waited when given
+//   InternalApiError, as returned with a 500 http status
+//   network timeouts, connections reset, refused or closed without
+//   response, unless the context of the request is done
+//
+// Otherwise the delay between two attempts grows exponentially from
+// BaseDelay up to MaxDelay, with a random jitter.
+//
+// Example
+//
+//   auth.RetryPolicy = &gads.RetryPolicy{
+//     MaxRetries: 5,
+//     MaxElapsed: 10 * time.Minute,
+//     BaseDelay:  time.Second,
+//     MaxDelay:   time.Minute,
+//   }
+//
+type RetryPolicy struct {
+	MaxRetries int           // maximum number of retries after the first attempt
+	MaxElapsed time.Duration // retries are given up past this duration, no limit if 0
+	BaseDelay  time.Duration // delay before the first retry, doubled on each retry
+	MaxDelay   time.Duration // maximum delay between two attempts, no limit if 0
+}
+
+// DefaultRetryPolicy is a sensible policy to use with the adwords api.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 5,
+	MaxElapsed: 10 * time.Minute,
+	BaseDelay:  time.Second,
+	MaxDelay:   time.Minute,
+}
+
+// do calls send until it succeeds, fails with an error that can't be
+// retried or the retry budget is spent, returning the last result.
+func (p *RetryPolicy) do(ctx context.Context, send func() ([]byte, error)) (respBody []byte, err error) {
+	start := time.Now()
+	for retry := 0; ; retry++ {
+		respBody, err = send()
+		if err == nil || retry >= p.MaxRetries || ctx.Err() != nil {
+			return respBody, err
+		}
+		serverDelay, retryable := retryDelay(err)
+		if !retryable {
+			return respBody, err
+		}
+		delay := serverDelay
+		if delay == 0 {
+			delay = p.backoff(retry)
+		}
+		if p.MaxElapsed > 0 && time.Since(start)+delay > p.MaxElapsed {
+			return respBody, err
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return respBody, err
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns the delay to wait before the retry number retry,
+// half of it being random.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.BaseDelay
+	for i := 0; i < retry && (p.MaxDelay == 0 || delay < p.MaxDelay); i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 1 {
+		return delay
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)))
+}
+
+// transientNetError tells if a network error may not happen on a new
+// attempt
+func transientNetError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// retryDelay tells if err can be retried, with the delay requested by the
+// server if any.
+func retryDelay(err error) (delay time.Duration, retryable bool) {
+	switch e := err.(type) {
+	case *url.Error:
+		// the request didn't reach the server or the response was lost,
+		// a bad url, an invalid certificate or a cassette mismatch fail
+		// again
+		if _, mismatch := e.Err.(CassetteError); mismatch {
+			return 0, false
+		}
+		return 0, transientNetError(e.Err)
+	case *ErrorsType:
+		for _, fault := range e.ApiExceptionFaults {
+			for _, apiError := range fault.Errors {
+				switch apiError := apiError.(type) {
+				case RateExceededError:
+					retryable = true
+					if d := time.Duration(apiError.RetryAfterSeconds) * time.Second; d > delay {
+						delay = d
+					}
+				case InternalApiError:
+					retryable = true
+				default:
+					// the request will fail again whatever happens
+					return 0, false
+				}
+			}
+		}
+	}
+	return delay, retryable
+}
@@ -0,0 +1,205 @@
+package gads
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+const testRateExceededFault = `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Body>
+    <soap:Fault>
+      <faultcode>soap:Server</faultcode>
+      <faultstring>[RateExceededError &lt;rateName=RATE_LIMIT, rateScope=ACCOUNT&gt;]</faultstring>
+      <detail>
+        <ApiExceptionFault xmlns="https://adwords.google.com/api/adwords/cm/v201806">
+          <message>[RateExceededError &lt;rateName=RATE_LIMIT, rateScope=ACCOUNT&gt;]</message>
+          <ApplicationException.Type>ApiException</ApplicationException.Type>
+          <errors xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="RateExceededError">
+            <fieldPath></fieldPath>
+            <trigger></trigger>
+            <errorString>RateExceededError.RATE_EXCEEDED</errorString>
+            <reason>RATE_EXCEEDED</reason>
+            <rateName>RATE_LIMIT</rateName>
+            <rateScope>ACCOUNT</rateScope>
+            <retryAfterSeconds>%d</retryAfterSeconds>
+          </errors>
+        </ApiExceptionFault>
+      </detail>
+    </soap:Fault>
+  </soap:Body>
+</soap:Envelope>`
+
+const testInternalApiFault = `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Body>
+    <soap:Fault>
+      <faultcode>soap:Server</faultcode>
+      <faultstring>[InternalApiError.UNEXPECTED_INTERNAL_API_ERROR]</faultstring>
+      <detail>
+        <ApiExceptionFault xmlns="https://adwords.google.com/api/adwords/cm/v201806">
+          <message>[InternalApiError.UNEXPECTED_INTERNAL_API_ERROR]</message>
+          <ApplicationException.Type>ApiException</ApplicationException.Type>
+          <errors xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="InternalApiError">
+            <errorString>InternalApiError.UNEXPECTED_INTERNAL_API_ERROR</errorString>
+            <reason>UNEXPECTED_INTERNAL_API_ERROR</reason>
+          </errors>
+        </ApiExceptionFault>
+      </detail>
+    </soap:Fault>
+  </soap:Body>
+</soap:Envelope>`
+
+const testCampaignGetResponse = `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Body>
+    <getResponse xmlns="https://adwords.google.com/api/adwords/cm/v201806">
+      <rval>
+        <totalNumEntries>1</totalNumEntries>
+        <entries>
+          <id>1234</id>
+          <name>test campaign</name>
+        </entries>
+      </rval>
+    </getResponse>
+  </soap:Body>
+</soap:Envelope>`
+
+// testFlakyServerAuth returns an Auth sending its requests to a server
+// failing with the given faults before answering testCampaignGetResponse.
+func testFlakyServerAuth(t *testing.T, calls *int32, faults ...string) (Auth, func()) {
+	return testServerAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		ioutil.ReadAll(r.Body)
+		call := atomic.AddInt32(calls, 1)
+		if int(call) <= len(faults) {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, faults[call-1])
+			return
+		}
+		fmt.Fprint(w, testCampaignGetResponse)
+	})
+}
+
+func TestRetryPolicy(t *testing.T) {
+	var calls int32
+	auth, cleanup := testFlakyServerAuth(t, &calls,
+		fmt.Sprintf(testRateExceededFault, 0),
+		testInternalApiFault,
+	)
+	defer cleanup()
+	auth.RetryPolicy = &RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond}
+
+	campaigns, totalCount, err := NewCampaignService(&auth).Get(Selector{Fields: []string{"Id", "Name"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+	if totalCount != 1 || len(campaigns) != 1 || campaigns[0].Id != 1234 {
+		t.Errorf("unexpected campaigns %#v", campaigns)
+	}
+}
+
+func TestRetryPolicyRetryAfter(t *testing.T) {
+	var calls int32
+	auth, cleanup := testFlakyServerAuth(t, &calls, fmt.Sprintf(testRateExceededFault, 1))
+	defer cleanup()
+	auth.RetryPolicy = &RetryPolicy{MaxRetries: 1, BaseDelay: time.Millisecond}
+
+	start := time.Now()
+	_, _, err := NewCampaignService(&auth).Get(Selector{Fields: []string{"Id"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected to wait for the server provided delay, waited %s", elapsed)
+	}
+}
+
+func TestRetryPolicyBudget(t *testing.T) {
+	var calls int32
+	fault := fmt.Sprintf(testRateExceededFault, 0)
+	auth, cleanup := testFlakyServerAuth(t, &calls, fault, fault, fault, fault)
+	defer cleanup()
+	auth.RetryPolicy = &RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond}
+
+	_, _, err := NewCampaignService(&auth).Get(Selector{Fields: []string{"Id"}})
+	if _, ok := err.(*ErrorsType); !ok {
+		t.Fatalf("expected the last fault to be returned, got %#v", err)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+
+	// out of time budget, the rate exceeded delay is not waited
+	calls = 0
+	auth, cleanup = testFlakyServerAuth(t, &calls, fmt.Sprintf(testRateExceededFault, 30))
+	defer cleanup()
+	auth.RetryPolicy = &RetryPolicy{MaxRetries: 2, MaxElapsed: time.Second}
+	if _, _, err = NewCampaignService(&auth).Get(Selector{Fields: []string{"Id"}}); err == nil {
+		t.Fatal("expected the fault to be returned")
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
+
+func TestRetryPolicyNetworkError(t *testing.T) {
+	var calls int32
+	auth, cleanup := testServerAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		ioutil.ReadAll(r.Body)
+		if atomic.AddInt32(&calls, 1) == 1 {
+			// drop the connection without answering
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+		fmt.Fprint(w, testCampaignGetResponse)
+	})
+	defer cleanup()
+	auth.RetryPolicy = &RetryPolicy{MaxRetries: 1, BaseDelay: time.Millisecond}
+
+	if _, _, err := NewCampaignService(&auth).Get(Selector{Fields: []string{"Id"}}); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	notRetryable := &ErrorsType{ApiExceptionFaults: []ApiExceptionFault{
+		{Errors: []interface{}{RateExceededError{}, CriterionError{Reason: "INVALID_ID"}}},
+	}}
+	if _, retryable := retryDelay(notRetryable); retryable {
+		t.Error("a fault with a non transient error must not be retried")
+	}
+	if _, retryable := retryDelay(ErrMissingCustomerId); retryable {
+		t.Error("unknown errors must not be retried")
+	}
+	for err, expected := range map[error]bool{
+		&url.Error{Op: "Post", URL: "ftp://example.com", Err: errors.New("unsupported protocol scheme")}: false,
+		&url.Error{Op: "Post", URL: "https://example.com", Err: x509.UnknownAuthorityError{}}:            false,
+		&url.Error{Op: "Post", URL: "https://example.com", Err: syscall.ECONNREFUSED}:                    true,
+		&url.Error{Op: "Post", URL: "https://example.com", Err: context.DeadlineExceeded}:                true,
+		&url.Error{Op: "Post", URL: "https://example.com", Err: io.EOF}:                                  true,
+	} {
+		if _, retryable := retryDelay(err); retryable != expected {
+			t.Errorf("%s: expected retryable to be %t", err, expected)
+		}
+	}
+	p := RetryPolicy{BaseDelay: time.Second, MaxDelay: 4 * time.Second}
+	for retry, max := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second} {
+		if d := p.backoff(retry); d < max/2 || d > max {
+			t.Errorf("backoff %d: expected a delay between %s and %s, got %s", retry, max/2, max, d)
+		}
+	}
+}
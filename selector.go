@@ -0,0 +1,226 @@
+package gads
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PredicateOperator is the operator of a Predicate
+type PredicateOperator string
+
+const (
+	OperatorEquals                   PredicateOperator = "EQUALS"
+	OperatorNotEquals                PredicateOperator = "NOT_EQUALS"
+	OperatorIn                       PredicateOperator = "IN"
+	OperatorNotIn                    PredicateOperator = "NOT_IN"
+	OperatorGreaterThan              PredicateOperator = "GREATER_THAN"
+	OperatorGreaterThanEquals        PredicateOperator = "GREATER_THAN_EQUALS"
+	OperatorLessThan                 PredicateOperator = "LESS_THAN"
+	OperatorLessThanEquals           PredicateOperator = "LESS_THAN_EQUALS"
+	OperatorStartsWith               PredicateOperator = "STARTS_WITH"
+	OperatorStartsWithIgnoreCase     PredicateOperator = "STARTS_WITH_IGNORE_CASE"
+	OperatorContains                 PredicateOperator = "CONTAINS"
+	OperatorContainsIgnoreCase       PredicateOperator = "CONTAINS_IGNORE_CASE"
+	OperatorDoesNotContain           PredicateOperator = "DOES_NOT_CONTAIN"
+	OperatorDoesNotContainIgnoreCase PredicateOperator = "DOES_NOT_CONTAIN_IGNORE_CASE"
+	OperatorContainsAny              PredicateOperator = "CONTAINS_ANY"
+	OperatorContainsAll              PredicateOperator = "CONTAINS_ALL"
+	OperatorContainsNone             PredicateOperator = "CONTAINS_NONE"
+)
+
+// Valid tells if the operator is known by the api
+func (o PredicateOperator) Valid() bool {
+	switch o {
+	case OperatorEquals, OperatorNotEquals, OperatorIn, OperatorNotIn,
+		OperatorGreaterThan, OperatorGreaterThanEquals, OperatorLessThan, OperatorLessThanEquals,
+		OperatorStartsWith, OperatorStartsWithIgnoreCase,
+		OperatorContains, OperatorContainsIgnoreCase, OperatorDoesNotContain, OperatorDoesNotContainIgnoreCase,
+		OperatorContainsAny, OperatorContainsAll, OperatorContainsNone:
+		return true
+	}
+	return false
+}
+
+// multiValued tells if the operator takes more than one value
+func (o PredicateOperator) multiValued() bool {
+	switch o {
+	case OperatorIn, OperatorNotIn, OperatorContainsAny, OperatorContainsAll, OperatorContainsNone:
+		return true
+	}
+	return false
+}
+
+// SortOrder is the order of an OrderBy
+type SortOrder string
+
+const (
+	SortAscending  SortOrder = "ASCENDING"
+	SortDescending SortOrder = "DESCENDING"
+)
+
+// FieldCatalog lists the fields a service can select and filter on. The
+// Get of a service with a catalog validates its selector before sending it.
+type FieldCatalog struct {
+	Service    string
+	Selectable []string
+	Filterable []string
+}
+
+func (c FieldCatalog) selectable(field string) bool {
+	return containsString(c.Selectable, field)
+}
+
+func (c FieldCatalog) filterable(field string) bool {
+	return containsString(c.Filterable, field)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the fields, predicates and ordering of the selector
+// against the catalog, it returns a *SelectorValidationError listing the
+// problems found if any.
+func (c FieldCatalog) Validate(selector Selector) error {
+	problems := validateSelector(selector)
+	for _, field := range selector.Fields {
+		if !c.selectable(field) {
+			problems = append(problems, fmt.Sprintf("field %q is not selectable", field))
+		}
+	}
+	for _, predicate := range selector.Predicates {
+		if !c.filterable(predicate.Field) {
+			problems = append(problems, fmt.Sprintf("field %q is not filterable", predicate.Field))
+		}
+	}
+	for _, orderBy := range selector.Ordering {
+		if !c.selectable(orderBy.Field) {
+			problems = append(problems, fmt.Sprintf("field %q is not sortable", orderBy.Field))
+		}
+	}
+	if len(problems) > 0 {
+		return &SelectorValidationError{Service: c.Service, Problems: problems}
+	}
+	return nil
+}
+
+// validateSelector returns the problems of the selector whatever the
+// service
+func validateSelector(selector Selector) (problems []string) {
+	if len(selector.Fields) == 0 {
+		problems = append(problems, "no field selected")
+	}
+	for _, predicate := range selector.Predicates {
+		operator := PredicateOperator(predicate.Operator)
+		switch {
+		case !operator.Valid():
+			problems = append(problems, fmt.Sprintf("unknown operator %q on %q", predicate.Operator, predicate.Field))
+		case len(predicate.Values) == 0:
+			problems = append(problems, fmt.Sprintf("no value for %s on %q", predicate.Operator, predicate.Field))
+		case len(predicate.Values) > 1 && !operator.multiValued():
+			problems = append(problems, fmt.Sprintf("%s on %q takes a single value", predicate.Operator, predicate.Field))
+		}
+	}
+	for _, orderBy := range selector.Ordering {
+		if order := SortOrder(orderBy.SortOrder); order != SortAscending && order != SortDescending {
+			problems = append(problems, fmt.Sprintf("unknown sort order %q on %q", orderBy.SortOrder, orderBy.Field))
+		}
+	}
+	if selector.Paging != nil && (selector.Paging.Offset < 0 || selector.Paging.Limit <= 0) {
+		problems = append(problems, fmt.Sprintf("invalid paging %d,%d", selector.Paging.Offset, selector.Paging.Limit))
+	}
+	return problems
+}
+
+// SelectorValidationError reports the problems of a selector found before
+// sending it.
+type SelectorValidationError struct {
+	Service  string // empty if the selector was not checked against a catalog
+	Problems []string
+}
+
+func (e *SelectorValidationError) Error() string {
+	if e.Service == "" {
+		return "invalid selector: " + strings.Join(e.Problems, ", ")
+	}
+	return "invalid " + e.Service + " selector: " + strings.Join(e.Problems, ", ")
+}
+
+// SelectorBuilder builds a Selector, checking it against the catalog of
+// the service it is meant for.
+//
+// Example
+//
+//   selector, err := gads.CampaignFields.Selector("Id", "Name").
+//     Where("Status", gads.OperatorIn, "ENABLED", "PAUSED").
+//     OrderBy("Name", gads.SortAscending).
+//     Build()
+//   campaigns, totalCount, err := campaignService.Get(selector)
+//
+type SelectorBuilder struct {
+	catalog  *FieldCatalog
+	selector Selector
+}
+
+// NewSelector returns a SelectorBuilder selecting fields, the fields are
+// not checked against any catalog.
+func NewSelector(fields ...string) *SelectorBuilder {
+	return &SelectorBuilder{selector: Selector{Fields: fields}}
+}
+
+// Selector returns a SelectorBuilder selecting fields, checked against the
+// catalog.
+func (c FieldCatalog) Selector(fields ...string) *SelectorBuilder {
+	return &SelectorBuilder{catalog: &c, selector: Selector{Fields: fields}}
+}
+
+// Fields adds fields to the selected ones
+func (b *SelectorBuilder) Fields(fields ...string) *SelectorBuilder {
+	b.selector.Fields = append(b.selector.Fields, fields...)
+	return b
+}
+
+// Where adds a predicate on field
+func (b *SelectorBuilder) Where(field string, operator PredicateOperator, values ...string) *SelectorBuilder {
+	b.selector.Predicates = append(b.selector.Predicates, Predicate{
+		Field:    field,
+		Operator: string(operator),
+		Values:   values,
+	})
+	return b
+}
+
+// OrderBy adds field to the ordering
+func (b *SelectorBuilder) OrderBy(field string, order SortOrder) *SelectorBuilder {
+	b.selector.Ordering = append(b.selector.Ordering, OrderBy{Field: field, SortOrder: string(order)})
+	return b
+}
+
+// During restricts the stats to the dates between min and max included
+func (b *SelectorBuilder) During(min, max Date) *SelectorBuilder {
+	b.selector.DateRange = &DateRange{Min: min, Max: max}
+	return b
+}
+
+// Paging sets the page of entities returned
+func (b *SelectorBuilder) Paging(offset, limit int64) *SelectorBuilder {
+	b.selector.Paging = &Paging{Offset: offset, Limit: limit}
+	return b
+}
+
+// Build returns the selector, or a *SelectorValidationError if it is not
+// valid.
+func (b *SelectorBuilder) Build() (Selector, error) {
+	if b.catalog != nil {
+		return b.selector, b.catalog.Validate(b.selector)
+	}
+	if problems := validateSelector(b.selector); len(problems) > 0 {
+		return b.selector, &SelectorValidationError{Problems: problems}
+	}
+	return b.selector, nil
+}
@@ -0,0 +1,92 @@
+package gads
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSelectorBuilder(t *testing.T) {
+	selector, err := CampaignFields.Selector("Id", "Name").
+		Fields("Status").
+		Where("Status", OperatorIn, "ENABLED", "PAUSED").
+		Where("Name", OperatorStartsWithIgnoreCase, "test").
+		OrderBy("Name", SortDescending).
+		Paging(0, 100).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Selector{
+		Fields: []string{"Id", "Name", "Status"},
+		Predicates: []Predicate{
+			{"Status", "IN", []string{"ENABLED", "PAUSED"}},
+			{"Name", "STARTS_WITH_IGNORE_CASE", []string{"test"}},
+		},
+		Ordering: []OrderBy{{"Name", "DESCENDING"}},
+		Paging:   &Paging{Offset: 0, Limit: 100},
+	}
+	if !reflect.DeepEqual(selector, expected) {
+		t.Errorf("expected %#v, got %#v", expected, selector)
+	}
+}
+
+func TestSelectorValidation(t *testing.T) {
+	_, err := CampaignFields.Selector("Id", "Nmae").
+		Where("Settings", OperatorEquals, "x").
+		Where("Status", OperatorEquals, "ENABLED", "PAUSED").
+		Where("Name", PredicateOperator("LIKE"), "test").
+		OrderBy("Id", SortOrder("UP")).
+		Build()
+	validationErr, ok := err.(*SelectorValidationError)
+	if !ok {
+		t.Fatalf("expected a validation error, got %#v", err)
+	}
+	expected := []string{
+		`EQUALS on "Status" takes a single value`,
+		`unknown operator "LIKE" on "Name"`,
+		`unknown sort order "UP" on "Id"`,
+		`field "Nmae" is not selectable`,
+		`field "Settings" is not filterable`,
+	}
+	if validationErr.Service != "CampaignService" || !reflect.DeepEqual(validationErr.Problems, expected) {
+		t.Errorf("expected the problems %q, got %q", expected, validationErr.Problems)
+	}
+
+	// without catalog only the operators and the values are checked
+	if _, err := NewSelector("Nmae").Where("Nmae", OperatorEquals, "x").Build(); err != nil {
+		t.Error(err)
+	}
+	if _, err := NewSelector().Where("Id", OperatorIn).Build(); err == nil {
+		t.Error("expected a selector without field and value to be rejected")
+	}
+}
+
+func TestGetValidatesSelector(t *testing.T) {
+	requests := []testRequest{}
+	auth, cleanup := testServiceAuth(t, &requests, func(request testRequest) string {
+		return "<totalNumEntries>0</totalNumEntries>"
+	})
+	defer cleanup()
+
+	_, _, err := NewCampaignService(&auth).Get(Selector{Fields: []string{"Id", "Nmae"}})
+	if validationErr, ok := err.(*SelectorValidationError); !ok || validationErr.Service != "CampaignService" {
+		t.Errorf("expected the campaign selector to be rejected, got %#v", err)
+	}
+	_, _, err = NewFeedItemService(&auth).Get(Selector{
+		Fields:     []string{"FeedItemId", "AttributeValues"},
+		Predicates: []Predicate{{Field: "AttributeValues", Operator: "EQUALS", Values: []string{"x"}}},
+	})
+	if validationErr, ok := err.(*SelectorValidationError); !ok || validationErr.Service != "FeedItemService" {
+		t.Errorf("expected the feed item selector to be rejected, got %#v", err)
+	}
+	if len(requests) != 0 {
+		t.Errorf("an invalid selector must not be sent, got %+v", requests)
+	}
+
+	if _, _, err := NewFeedItemService(&auth).Get(Selector{Fields: []string{"FeedItemId"}}); err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) != 1 {
+		t.Errorf("expected a valid selector to be sent, got %d requests", len(requests))
+	}
+}
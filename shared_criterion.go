@@ -1,5 +1,9 @@
 package gads
 
+import (
+	"encoding/xml"
+)
+
 type SharedCriterionService struct {
 	Auth
 }
@@ -7,3 +11,183 @@ type SharedCriterionService struct {
 func NewSharedCriterionService(auth *Auth) *SharedCriterionService {
 	return &SharedCriterionService{Auth: *auth}
 }
+
+// SharedCriterion is a criterion of a shared set, a KeywordCriterion in a
+// NEGATIVE_KEYWORDS set or a PlacementCriterion in a NEGATIVE_PLACEMENTS
+// one.
+type SharedCriterion struct {
+	SharedSetId int64     `xml:"sharedSetId"`
+	Criterion   Criterion `xml:"criterion"`
+	Negative    bool      `xml:"negative"`
+}
+
+func (sc *SharedCriterion) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	for token, err := dec.Token(); err == nil; token, err = dec.Token() {
+		if err != nil {
+			return err
+		}
+		switch start := token.(type) {
+		case xml.StartElement:
+			switch start.Name.Local {
+			case "sharedSetId":
+				if err := dec.DecodeElement(&sc.SharedSetId, &start); err != nil {
+					return err
+				}
+			case "criterion":
+				criterion, err := criterionUnmarshalXML(dec, start)
+				if err != nil {
+					return err
+				}
+				sc.Criterion = criterion
+			case "negative":
+				if err := dec.DecodeElement(&sc.Negative, &start); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// SharedCriterionOperations is a map of operations to perform on
+// SharedCriterion's, shared criteria can only be added and removed.
+type SharedCriterionOperations map[string][]SharedCriterion
+
+// SharedCriterionFields lists the fields of the shared criteria the
+// SharedCriterionService selects and filters on, see FieldCatalog.
+var SharedCriterionFields = FieldCatalog{
+	Service: "SharedCriterionService",
+	Selectable: []string{
+		"SharedSetId", "Id", "CriteriaType", "Negative", "KeywordText", "KeywordMatchType",
+		"PlacementUrl",
+	},
+	Filterable: []string{
+		"SharedSetId", "Id", "CriteriaType", "KeywordText", "KeywordMatchType", "PlacementUrl",
+	},
+}
+
+// Get returns an array of SharedCriterion's and the total number of
+// SharedCriterion's matching the selector.
+//
+// Example
+//
+//   sharedCriteria, totalCount, err := sharedCriterionService.Get(
+//     gads.Selector{
+//       Fields: []string{"SharedSetId", "Id", "KeywordText", "KeywordMatchType"},
+//       Predicates: []gads.Predicate{
+//         {"SharedSetId", "EQUALS", []string{sharedSetId}},
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/SharedCriterionService#get
+//
+func (s *SharedCriterionService) Get(selector Selector) (sharedCriteria []SharedCriterion, totalCount int64, err error) {
+	if err := SharedCriterionFields.Validate(selector); err != nil {
+		return sharedCriteria, totalCount, err
+	}
+	selector.XMLName = xml.Name{"", "selector"}
+	respBody, err := s.Auth.request(
+		sharedCriterionServiceUrl,
+		"get",
+		struct {
+			XMLName xml.Name
+			Sel     Selector
+		}{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(sharedCriterionServiceUrl),
+				Local: "get",
+			},
+			Sel: selector,
+		},
+	)
+	if err != nil {
+		return sharedCriteria, totalCount, err
+	}
+	getResp := struct {
+		Size           int64             `xml:"rval>totalNumEntries"`
+		SharedCriteria []SharedCriterion `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return sharedCriteria, totalCount, err
+	}
+	return getResp.SharedCriteria, getResp.Size, err
+}
+
+// All returns a Pager over all the shared criteria matching selector.
+func (s *SharedCriterionService) All(selector Selector) *Pager[SharedCriterion] {
+	return NewPager(s.Get, selector)
+}
+
+// Mutate allows you to add and remove the criteria of shared sets,
+// returning the modified criteria.
+//
+// Example
+//
+//   sharedCriteria, err := sharedCriterionService.Mutate(
+//     gads.SharedCriterionOperations{
+//       "ADD": {
+//         gads.SharedCriterion{
+//           SharedSetId: sharedSet.Id,
+//           Criterion:   gads.KeywordCriterion{Text: "free", MatchType: "BROAD"},
+//           Negative:    true,
+//         },
+//       },
+//       "REMOVE": {
+//         gads.SharedCriterion{SharedSetId: sharedSet.Id, Criterion: gads.KeywordCriterion{Id: 1234}},
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/SharedCriterionService#mutate
+//
+func (s *SharedCriterionService) Mutate(sharedCriterionOperations SharedCriterionOperations) (sharedCriteria []SharedCriterion, err error) {
+	type sharedCriterionOperation struct {
+		Action          string          `xml:"operator"`
+		SharedCriterion SharedCriterion `xml:"operand"`
+	}
+	operations := []sharedCriterionOperation{}
+	for action, sharedCriteria := range sharedCriterionOperations {
+		for _, sharedCriterion := range sharedCriteria {
+			operations = append(operations,
+				sharedCriterionOperation{
+					Action:          action,
+					SharedCriterion: sharedCriterion,
+				},
+			)
+		}
+	}
+	mutation := struct {
+		XMLName xml.Name
+		Ops     []sharedCriterionOperation `xml:"operations"`
+	}{
+		XMLName: xml.Name{
+			Space: s.Auth.namespace(sharedCriterionServiceUrl),
+			Local: "mutate",
+		},
+		Ops: operations,
+	}
+	respBody, err := s.Auth.request(sharedCriterionServiceUrl, "mutate", mutation)
+	if err != nil {
+		return sharedCriteria, err
+	}
+	mutateResp := struct {
+		BaseResponse
+		SharedCriteria []SharedCriterion `xml:"rval>value"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &mutateResp)
+	if err != nil {
+		return sharedCriteria, err
+	}
+
+	if len(mutateResp.PartialFailureErrors) > 0 {
+		err = mutateResp.PartialFailureErrors
+	}
+
+	return mutateResp.SharedCriteria, err
+}
@@ -1,5 +1,9 @@
 package gads
 
+import (
+	"encoding/xml"
+)
+
 type SharedSetService struct {
 	Auth
 }
@@ -7,3 +11,154 @@ type SharedSetService struct {
 func NewSharedSetService(auth *Auth) *SharedSetService {
 	return &SharedSetService{Auth: *auth}
 }
+
+// SharedSet is a list of criteria shared by campaigns, as negative
+// keywords or excluded placements.
+//
+// SharedSetType: NEGATIVE_KEYWORDS, NEGATIVE_PLACEMENTS
+// Status: ENABLED, REMOVED
+type SharedSet struct {
+	Id             int64  `xml:"sharedSetId,omitempty"`
+	Name           string `xml:"name,omitempty"`
+	SharedSetType  string `xml:"type,omitempty"`
+	MemberCount    int64  `xml:"memberCount,omitempty"`
+	ReferenceCount int64  `xml:"referenceCount,omitempty"`
+	Status         string `xml:"status,omitempty"`
+}
+
+// SharedSetOperations is a map of operations to perform on SharedSet's
+type SharedSetOperations map[string][]SharedSet
+
+// SharedSetFields lists the fields of the shared sets the SharedSetService
+// selects and filters on, see FieldCatalog.
+var SharedSetFields = FieldCatalog{
+	Service: "SharedSetService",
+	Selectable: []string{
+		"SharedSetId", "Name", "Type", "MemberCount", "ReferenceCount", "Status",
+	},
+	Filterable: []string{
+		"SharedSetId", "Name", "Type", "MemberCount", "ReferenceCount", "Status",
+	},
+}
+
+// Get returns an array of SharedSet's and the total number of SharedSet's
+// matching the selector.
+//
+// Example
+//
+//   sharedSets, totalCount, err := sharedSetService.Get(
+//     gads.Selector{
+//       Fields: []string{"SharedSetId", "Name", "Type", "MemberCount"},
+//       Predicates: []gads.Predicate{
+//         {"Type", "EQUALS", []string{"NEGATIVE_KEYWORDS"}},
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/SharedSetService#get
+//
+func (s *SharedSetService) Get(selector Selector) (sharedSets []SharedSet, totalCount int64, err error) {
+	if err := SharedSetFields.Validate(selector); err != nil {
+		return sharedSets, totalCount, err
+	}
+	selector.XMLName = xml.Name{"", "selector"}
+	respBody, err := s.Auth.request(
+		sharedSetServiceUrl,
+		"get",
+		struct {
+			XMLName xml.Name
+			Sel     Selector
+		}{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(sharedSetServiceUrl),
+				Local: "get",
+			},
+			Sel: selector,
+		},
+	)
+	if err != nil {
+		return sharedSets, totalCount, err
+	}
+	getResp := struct {
+		Size       int64       `xml:"rval>totalNumEntries"`
+		SharedSets []SharedSet `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return sharedSets, totalCount, err
+	}
+	return getResp.SharedSets, getResp.Size, err
+}
+
+// All returns a Pager over all the shared sets matching selector.
+func (s *SharedSetService) All(selector Selector) *Pager[SharedSet] {
+	return NewPager(s.Get, selector)
+}
+
+// Mutate allows you to add, rename and remove shared sets, returning the
+// modified shared sets.
+//
+// Example
+//
+//   sharedSets, err := sharedSetService.Mutate(
+//     gads.SharedSetOperations{
+//       "ADD": {
+//         gads.SharedSet{Name: "brand negatives", SharedSetType: "NEGATIVE_KEYWORDS"},
+//       },
+//       "REMOVE": {
+//         gads.SharedSet{Id: 1234},
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/SharedSetService#mutate
+//
+func (s *SharedSetService) Mutate(sharedSetOperations SharedSetOperations) (sharedSets []SharedSet, err error) {
+	type sharedSetOperation struct {
+		Action    string    `xml:"operator"`
+		SharedSet SharedSet `xml:"operand"`
+	}
+	operations := []sharedSetOperation{}
+	for action, sharedSets := range sharedSetOperations {
+		for _, sharedSet := range sharedSets {
+			operations = append(operations,
+				sharedSetOperation{
+					Action:    action,
+					SharedSet: sharedSet,
+				},
+			)
+		}
+	}
+	mutation := struct {
+		XMLName xml.Name
+		Ops     []sharedSetOperation `xml:"operations"`
+	}{
+		XMLName: xml.Name{
+			Space: s.Auth.namespace(sharedSetServiceUrl),
+			Local: "mutate",
+		},
+		Ops: operations,
+	}
+	respBody, err := s.Auth.request(sharedSetServiceUrl, "mutate", mutation)
+	if err != nil {
+		return sharedSets, err
+	}
+	mutateResp := struct {
+		BaseResponse
+		SharedSets []SharedSet `xml:"rval>value"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &mutateResp)
+	if err != nil {
+		return sharedSets, err
+	}
+
+	if len(mutateResp.PartialFailureErrors) > 0 {
+		err = mutateResp.PartialFailureErrors
+	}
+
+	return mutateResp.SharedSets, err
+}
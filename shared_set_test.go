@@ -0,0 +1,95 @@
+package gads
+
+import (
+	"strings"
+	"testing"
+)
+
+var testSharedSetValues = map[string]string{
+	"SharedSetService": `
+        <value>
+          <sharedSetId>71</sharedSetId>
+          <name>brand negatives</name>
+          <type>NEGATIVE_KEYWORDS</type>
+          <memberCount>0</memberCount>
+          <referenceCount>0</referenceCount>
+          <status>ENABLED</status>
+        </value>`,
+	"SharedCriterionService": `
+        <value>
+          <sharedSetId>71</sharedSetId>
+          <criterion xsi:type="Keyword"><id>81</id><type>KEYWORD</type><text>free</text><matchType>BROAD</matchType></criterion>
+          <negative>true</negative>
+        </value>
+        <value>
+          <sharedSetId>71</sharedSetId>
+          <criterion xsi:type="Placement"><id>82</id><type>PLACEMENT</type><url>example.com</url></criterion>
+          <negative>true</negative>
+        </value>`,
+	"CampaignSharedSetService": `
+        <value><sharedSetId>71</sharedSetId><campaignId>1</campaignId><status>ENABLED</status></value>
+        <value><sharedSetId>71</sharedSetId><campaignId>2</campaignId><status>ENABLED</status></value>`,
+}
+
+func TestSharedSet(t *testing.T) {
+	var requests []testRequest
+	auth, cleanup := testServiceAuth(t, &requests, func(request testRequest) string {
+		return testSharedSetValues[request.Service]
+	})
+	defer cleanup()
+
+	// create a list
+	sharedSets, err := NewSharedSetService(&auth).Mutate(SharedSetOperations{
+		"ADD": {{Name: "brand negatives", SharedSetType: "NEGATIVE_KEYWORDS"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sharedSets) != 1 || sharedSets[0].Id != 71 || sharedSets[0].SharedSetType != "NEGATIVE_KEYWORDS" {
+		t.Fatalf("unexpected shared sets %#v", sharedSets)
+	}
+	if body := requests[0].Body; !strings.Contains(body, "<type>NEGATIVE_KEYWORDS</type>") || strings.Contains(body, "sharedSetId") {
+		t.Errorf("unexpected request\n%s", body)
+	}
+
+	// fill it
+	sharedCriteria, err := NewSharedCriterionService(&auth).Mutate(SharedCriterionOperations{
+		"ADD": {
+			{SharedSetId: sharedSets[0].Id, Criterion: KeywordCriterion{Text: "free", MatchType: "BROAD"}, Negative: true},
+			{SharedSetId: sharedSets[0].Id, Criterion: PlacementCriterion{Url: "example.com"}, Negative: true},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sharedCriteria) != 2 {
+		t.Fatalf("unexpected shared criteria %#v", sharedCriteria)
+	}
+	if keyword, ok := sharedCriteria[0].Criterion.(KeywordCriterion); !ok || keyword.Id != 81 || !sharedCriteria[0].Negative {
+		t.Errorf("unexpected shared criterion %#v", sharedCriteria[0])
+	}
+	if placement, ok := sharedCriteria[1].Criterion.(PlacementCriterion); !ok || placement.Url != "example.com" {
+		t.Errorf("unexpected shared criterion %#v", sharedCriteria[1])
+	}
+	body := requests[1].Body
+	if !strings.Contains(body, `<criterion xsi:type="Keyword">`) || !strings.Contains(body, `<criterion xsi:type="Placement">`) {
+		t.Errorf("unexpected request\n%s", body)
+	}
+
+	// attach it to campaigns
+	campaignSharedSets, err := NewCampaignSharedSetService(&auth).Mutate(CampaignSharedSetOperations{
+		"ADD": {
+			{SharedSetId: sharedSets[0].Id, CampaignId: 1},
+			{SharedSetId: sharedSets[0].Id, CampaignId: 2},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(campaignSharedSets) != 2 || campaignSharedSets[1].CampaignId != 2 || campaignSharedSets[1].Status != "ENABLED" {
+		t.Errorf("unexpected campaign shared sets %#v", campaignSharedSets)
+	}
+	if body := requests[2].Body; strings.Count(body, "<sharedSetId>71</sharedSetId>") != 2 {
+		t.Errorf("unexpected request\n%s", body)
+	}
+}
@@ -1,5 +1,10 @@
 package gads
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 type TargetIdeaService struct {
 	Auth
 }
@@ -7,3 +12,315 @@ type TargetIdeaService struct {
 func NewTargetIdeaService(auth *Auth) *TargetIdeaService {
 	return &TargetIdeaService{Auth: *auth}
 }
+
+// RequestType: IDEAS, STATS
+// IdeaType: KEYWORD, PLACEMENT
+// RequestedAttributeTypes:
+//   https://developers.google.com/adwords/api/docs/reference/v201806/TargetingIdeaService.AttributeType
+type TargetingIdeaSelector struct {
+	XMLName                 xml.Name
+	SearchParameters        []SearchParameter `xml:"searchParameters"`
+	IdeaType                string            `xml:"ideaType"`
+	RequestType             string            `xml:"requestType"`
+	RequestedAttributeTypes []string          `xml:"requestedAttributeTypes"`
+	Paging                  *Paging           `xml:"paging,omitempty"`
+	LocaleCode              string            `xml:"localeCode,omitempty"`
+	CurrencyCode            string            `xml:"currencyCode,omitempty"`
+}
+
+// SearchParameter is one of the *SearchParameter types restricting the
+// ideas returned
+type SearchParameter interface {
+	GetType() string
+}
+
+type RelatedToQuerySearchParameter struct {
+	Type    string   `xml:"xsi:type,attr,omitempty"`
+	Queries []string `xml:"queries"`
+}
+
+func (p RelatedToQuerySearchParameter) GetType() string {
+	return "RelatedToQuerySearchParameter"
+}
+
+type RelatedToUrlSearchParameter struct {
+	Type           string   `xml:"xsi:type,attr,omitempty"`
+	Urls           []string `xml:"urls"`
+	IncludeSubUrls bool     `xml:"includeSubUrls,omitempty"`
+}
+
+func (p RelatedToUrlSearchParameter) GetType() string {
+	return "RelatedToUrlSearchParameter"
+}
+
+type LanguageSearchParameter struct {
+	Type      string              `xml:"xsi:type,attr,omitempty"`
+	Languages []LanguageCriterion `xml:"languages"`
+}
+
+func (p LanguageSearchParameter) GetType() string {
+	return "LanguageSearchParameter"
+}
+
+type LocationSearchParameter struct {
+	Type      string     `xml:"xsi:type,attr,omitempty"`
+	Locations []Location `xml:"locations"`
+}
+
+func (p LocationSearchParameter) GetType() string {
+	return "LocationSearchParameter"
+}
+
+type NetworkSearchParameter struct {
+	Type           string         `xml:"xsi:type,attr,omitempty"`
+	NetworkSetting NetworkSetting `xml:"networkSetting"`
+}
+
+func (p NetworkSearchParameter) GetType() string {
+	return "NetworkSearchParameter"
+}
+
+// Levels: LOW, MEDIUM, HIGH, UNKNOWN
+type CompetitionSearchParameter struct {
+	Type   string   `xml:"xsi:type,attr,omitempty"`
+	Levels []string `xml:"levels"`
+}
+
+func (p CompetitionSearchParameter) GetType() string {
+	return "CompetitionSearchParameter"
+}
+
+// Minimum, Maximum: average monthly searches, nil for no bound
+type SearchVolumeSearchParameter struct {
+	Type    string `xml:"xsi:type,attr,omitempty"`
+	Minimum *int64 `xml:"operation>minimum,omitempty"`
+	Maximum *int64 `xml:"operation>maximum,omitempty"`
+}
+
+func (p SearchVolumeSearchParameter) GetType() string {
+	return "SearchVolumeSearchParameter"
+}
+
+// TargetingIdea maps the requested attribute types to their values, which
+// are one of the *Attribute types.
+type TargetingIdea map[string]Attribute
+
+// Attribute is the value of an attribute of a TargetingIdea
+type Attribute interface{}
+
+type StringAttribute struct {
+	Value string `xml:"value"`
+}
+
+type LongAttribute struct {
+	Value int64 `xml:"value"`
+}
+
+type IntegerAttribute struct {
+	Value int `xml:"value"`
+}
+
+type DoubleAttribute struct {
+	Value float64 `xml:"value"`
+}
+
+type BooleanAttribute struct {
+	Value bool `xml:"value"`
+}
+
+// Value: in micros
+type MoneyAttribute struct {
+	Value int64 `xml:"value>microAmount"`
+}
+
+type IdeaTypeAttribute struct {
+	Value string `xml:"value"`
+}
+
+type IntegerSetAttribute struct {
+	Value []int `xml:"value"`
+}
+
+type KeywordAttribute struct {
+	Value KeywordCriterion `xml:"value"`
+}
+
+type MonthlySearchVolumeAttribute struct {
+	Value []MonthlySearchVolume `xml:"value"`
+}
+
+type MonthlySearchVolume struct {
+	Year  int   `xml:"year"`
+	Month int   `xml:"month"`
+	Count int64 `xml:"count"`
+}
+
+func (idea *TargetingIdea) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	*idea = TargetingIdea{}
+	for {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch start := token.(type) {
+		case xml.StartElement:
+			if start.Name.Local != "data" {
+				if err := dec.Skip(); err != nil {
+					return err
+				}
+				continue
+			}
+			key, attribute, err := attributeEntryUnmarshalXML(dec)
+			if err != nil {
+				return err
+			}
+			if attribute != nil {
+				(*idea)[key] = attribute
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// attributeEntryUnmarshalXML decodes the key and the value of a data entry
+// of a TargetingIdea
+func attributeEntryUnmarshalXML(dec *xml.Decoder) (key string, attribute Attribute, err error) {
+	for {
+		token, err := dec.Token()
+		if err != nil {
+			return key, attribute, err
+		}
+		switch start := token.(type) {
+		case xml.StartElement:
+			switch start.Name.Local {
+			case "key":
+				err = dec.DecodeElement(&key, &start)
+			case "value":
+				attribute, err = attributeUnmarshalXML(dec, start)
+			default:
+				err = dec.Skip()
+			}
+			if err != nil {
+				return key, attribute, err
+			}
+		case xml.EndElement:
+			return key, attribute, nil
+		}
+	}
+}
+
+func attributeUnmarshalXML(dec *xml.Decoder, start xml.StartElement) (Attribute, error) {
+	attributeType, err := findAttr(start.Attr, xml.Name{Space: "http://www.w3.org/2001/XMLSchema-instance", Local: "type"})
+	if err != nil {
+		return nil, err
+	}
+	switch attributeType {
+	case "StringAttribute":
+		a := StringAttribute{}
+		return a, dec.DecodeElement(&a, &start)
+	case "LongAttribute":
+		a := LongAttribute{}
+		return a, dec.DecodeElement(&a, &start)
+	case "IntegerAttribute":
+		a := IntegerAttribute{}
+		return a, dec.DecodeElement(&a, &start)
+	case "DoubleAttribute":
+		a := DoubleAttribute{}
+		return a, dec.DecodeElement(&a, &start)
+	case "BooleanAttribute":
+		a := BooleanAttribute{}
+		return a, dec.DecodeElement(&a, &start)
+	case "MoneyAttribute":
+		a := MoneyAttribute{}
+		return a, dec.DecodeElement(&a, &start)
+	case "IdeaTypeAttribute":
+		a := IdeaTypeAttribute{}
+		return a, dec.DecodeElement(&a, &start)
+	case "IntegerSetAttribute":
+		a := IntegerSetAttribute{}
+		return a, dec.DecodeElement(&a, &start)
+	case "KeywordAttribute":
+		a := KeywordAttribute{}
+		return a, dec.DecodeElement(&a, &start)
+	case "MonthlySearchVolumeAttribute":
+		a := MonthlySearchVolumeAttribute{}
+		return a, dec.DecodeElement(&a, &start)
+	default:
+		if StrictMode {
+			return nil, fmt.Errorf("unknown attribute type %#v", attributeType)
+		}
+		return nil, dec.Skip()
+	}
+}
+
+// Get returns the keyword ideas, or the stats of the keywords, matching
+// the search parameters of the selector
+//
+// Example
+//
+//   ideas, totalCount, err := targetIdeaService.Get(
+//     gads.TargetingIdeaSelector{
+//       IdeaType:    "KEYWORD",
+//       RequestType: "IDEAS",
+//       RequestedAttributeTypes: []string{
+//         "KEYWORD_TEXT", "SEARCH_VOLUME", "TARGETED_MONTHLY_SEARCHES", "COMPETITION",
+//       },
+//       SearchParameters: []gads.SearchParameter{
+//         gads.RelatedToQuerySearchParameter{Queries: []string{"mars cruise"}},
+//         gads.LanguageSearchParameter{Languages: []gads.LanguageCriterion{{Id: 1000}}},
+//       },
+//       Paging: &gads.Paging{Offset: 0, Limit: 100},
+//     },
+//   )
+//   for _, idea := range ideas {
+//     text := idea["KEYWORD_TEXT"].(gads.StringAttribute).Value
+//     volumes := idea["TARGETED_MONTHLY_SEARCHES"].(gads.MonthlySearchVolumeAttribute).Value
+//   }
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/TargetingIdeaService#get
+//
+func (s *TargetIdeaService) Get(selector TargetingIdeaSelector) (ideas []TargetingIdea, totalCount int64, err error) {
+	selector.XMLName = xml.Name{"", "selector"}
+	respBody, err := s.Auth.request(
+		targetingIdeaServiceUrl,
+		"get",
+		struct {
+			XMLName xml.Name
+			Sel     TargetingIdeaSelector
+		}{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(targetingIdeaServiceUrl),
+				Local: "get",
+			},
+			Sel: selector,
+		},
+	)
+	if err != nil {
+		return ideas, totalCount, err
+	}
+	getResp := struct {
+		Size  int64           `xml:"rval>totalNumEntries"`
+		Ideas []TargetingIdea `xml:"rval>entries"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return ideas, totalCount, err
+	}
+	return getResp.Ideas, getResp.Size, err
+}
+
+// All returns a Pager over all the ideas matching the selector
+func (s *TargetIdeaService) All(selector TargetingIdeaSelector) *Pager[TargetingIdea] {
+	return &Pager[TargetingIdea]{
+		get: func(paging Paging) ([]TargetingIdea, int64, error) {
+			selector := selector
+			selector.Paging = &paging
+			return s.Get(selector)
+		},
+		paging: selector.Paging,
+		index:  -1,
+	}
+}
@@ -0,0 +1,137 @@
+package gads
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testTargetingIdeaResponse = `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Body>
+    <getResponse xmlns="https://adwords.google.com/api/adwords/o/v201806" xmlns:cm="https://adwords.google.com/api/adwords/cm/v201806" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">
+      <rval>
+        <totalNumEntries>%d</totalNumEntries>
+        <entries>
+          <data>
+            <key>KEYWORD_TEXT</key>
+            <value xsi:type="StringAttribute">
+              <Attribute.Type>StringAttribute</Attribute.Type>
+              <value>mars cruise %d</value>
+            </value>
+          </data>
+          <data>
+            <key>SEARCH_VOLUME</key>
+            <value xsi:type="LongAttribute"><Attribute.Type>LongAttribute</Attribute.Type><value>2900</value></value>
+          </data>
+          <data>
+            <key>AVERAGE_CPC</key>
+            <value xsi:type="MoneyAttribute"><value><cm:microAmount>1230000</cm:microAmount></value></value>
+          </data>
+          <data>
+            <key>COMPETITION</key>
+            <value xsi:type="DoubleAttribute"><value>0.58</value></value>
+          </data>
+          <data>
+            <key>CATEGORY_PRODUCTS_AND_SERVICES</key>
+            <value xsi:type="IntegerSetAttribute"><value>10019</value><value>10868</value></value>
+          </data>
+          <data>
+            <key>TARGETED_MONTHLY_SEARCHES</key>
+            <value xsi:type="MonthlySearchVolumeAttribute">
+              <value><year>2018</year><month>7</month><count>2400</count></value>
+              <value><year>2018</year><month>6</month><count>3600</count></value>
+            </value>
+          </data>
+          <data>
+            <key>EXTRACTED_FROM_WEBPAGE</key>
+            <value xsi:type="WebpageDescriptorAttribute"><value><url>http://example.com</url></value></value>
+          </data>
+        </entries>
+      </rval>
+    </getResponse>
+  </soap:Body>
+</soap:Envelope>`
+
+func TestTargetingIdea(t *testing.T) {
+	var path string
+	var bodies []string
+	auth, cleanup := testServerAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		path = r.URL.Path
+		bodies = append(bodies, string(b))
+		fmt.Fprintf(w, testTargetingIdeaResponse, 2, len(bodies))
+	})
+	defer cleanup()
+
+	minimum := int64(1000)
+	selector := TargetingIdeaSelector{
+		IdeaType:                "KEYWORD",
+		RequestType:             "IDEAS",
+		RequestedAttributeTypes: []string{"KEYWORD_TEXT", "SEARCH_VOLUME", "TARGETED_MONTHLY_SEARCHES"},
+		SearchParameters: []SearchParameter{
+			RelatedToQuerySearchParameter{Queries: []string{"mars cruise"}},
+			RelatedToUrlSearchParameter{Urls: []string{"http://example.com"}},
+			LanguageSearchParameter{Languages: []LanguageCriterion{{Id: 1000}}},
+			LocationSearchParameter{Locations: []Location{{Id: 2840}}},
+			NetworkSearchParameter{NetworkSetting: NetworkSetting{TargetGoogleSearch: true}},
+			CompetitionSearchParameter{Levels: []string{"LOW", "MEDIUM"}},
+			SearchVolumeSearchParameter{Minimum: &minimum},
+		},
+	}
+	ideas := NewTargetIdeaService(&auth).All(selector)
+	ideas.PageSize = 1
+	count := 0
+	for ideas.Next() {
+		count++
+		idea := ideas.Value()
+		if text := idea["KEYWORD_TEXT"].(StringAttribute).Value; text != fmt.Sprintf("mars cruise %d", count) {
+			t.Errorf("unexpected keyword text %s", text)
+		}
+		if idea["SEARCH_VOLUME"] != (LongAttribute{2900}) || idea["AVERAGE_CPC"] != (MoneyAttribute{1230000}) ||
+			idea["COMPETITION"] != (DoubleAttribute{0.58}) {
+			t.Errorf("unexpected idea %#v", idea)
+		}
+		if !reflect.DeepEqual(idea["CATEGORY_PRODUCTS_AND_SERVICES"], IntegerSetAttribute{[]int{10019, 10868}}) {
+			t.Errorf("unexpected categories %#v", idea["CATEGORY_PRODUCTS_AND_SERVICES"])
+		}
+		expectedVolumes := MonthlySearchVolumeAttribute{[]MonthlySearchVolume{{2018, 7, 2400}, {2018, 6, 3600}}}
+		if !reflect.DeepEqual(idea["TARGETED_MONTHLY_SEARCHES"], expectedVolumes) {
+			t.Errorf("unexpected monthly searches %#v", idea["TARGETED_MONTHLY_SEARCHES"])
+		}
+		if _, ok := idea["EXTRACTED_FROM_WEBPAGE"]; ok {
+			t.Error("expected the unknown attribute to be skipped")
+		}
+	}
+	if err := ideas.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("expected 2 ideas, got %d", count)
+	}
+
+	if path != "/o/v201806/TargetingIdeaService" {
+		t.Errorf("unexpected request path %s", path)
+	}
+	for _, expected := range []string{
+		`<searchParameters xsi:type="RelatedToQuerySearchParameter">`,
+		`<searchParameters xsi:type="RelatedToUrlSearchParameter">`,
+		`<searchParameters xsi:type="LanguageSearchParameter">`,
+		`<languages xsi:type="Language">`,
+		`<locations xsi:type="Location">`,
+		`<searchParameters xsi:type="NetworkSearchParameter">`,
+		`<levels>MEDIUM</levels>`,
+		`<minimum>1000</minimum>`,
+		`<requestType>IDEAS</requestType>`,
+		`<startIndex>1</startIndex>`,
+	} {
+		if !strings.Contains(bodies[1], expected) {
+			t.Errorf("expected %s in the request\n%s", expected, bodies[1])
+		}
+	}
+	if strings.Contains(bodies[1], "maximum") {
+		t.Errorf("unexpected maximum in the request\n%s", bodies[1])
+	}
+}
@@ -1,5 +1,9 @@
 package gads
 
+import (
+	"encoding/xml"
+)
+
 type TrafficEstimatorService struct {
 	Auth
 }
@@ -7,3 +11,140 @@ type TrafficEstimatorService struct {
 func NewTrafficEstimatorService(auth *Auth) *TrafficEstimatorService {
 	return &TrafficEstimatorService{Auth: *auth}
 }
+
+// TrafficEstimatorSelector lists the campaigns, ad groups and keywords to
+// estimate the traffic of.
+type TrafficEstimatorSelector struct {
+	XMLName                   xml.Name
+	CampaignEstimateRequests  []CampaignEstimateRequest `xml:"campaignEstimateRequests"`
+	PlatformEstimateRequested bool                      `xml:"platformEstimateRequested,omitempty"`
+}
+
+// CampaignId: existing campaign, 0 for a new one
+// Criteria: LanguageCriterion and Location targeting the campaign
+// DailyBudget: in micros, nil to use the one of the campaign
+type CampaignEstimateRequest struct {
+	CampaignId              int64                    `xml:"campaignId,omitempty"`
+	AdGroupEstimateRequests []AdGroupEstimateRequest `xml:"adGroupEstimateRequests"`
+	Criteria                []Criterion              `xml:"criteria,omitempty"`
+	NetworkSetting          *NetworkSetting          `xml:"networkSetting,omitempty"`
+	DailyBudget             *int64                   `xml:"dailyBudget>microAmount,omitempty"`
+}
+
+// AdGroupId: existing ad group, 0 for a new one
+// MaxCpc: in micros, default max cpc of the keywords
+type AdGroupEstimateRequest struct {
+	AdGroupId               int64                    `xml:"adGroupId,omitempty"`
+	KeywordEstimateRequests []KeywordEstimateRequest `xml:"keywordEstimateRequests"`
+	MaxCpc                  *int64                   `xml:"maxCpc>microAmount,omitempty"`
+}
+
+// Keyword: existing keyword by Id, or new one by Text and MatchType
+// MaxCpc: in micros, nil to use the one of the ad group
+// IsNegative: the keyword is excluded from the estimates of the others
+type KeywordEstimateRequest struct {
+	Keyword    KeywordCriterion `xml:"keyword"`
+	MaxCpc     *int64           `xml:"maxCpc>microAmount,omitempty"`
+	IsNegative bool             `xml:"isNegative,omitempty"`
+}
+
+type TrafficEstimatorResult struct {
+	CampaignEstimates []CampaignEstimate `xml:"campaignEstimates"`
+}
+
+type CampaignEstimate struct {
+	CampaignId        int64              `xml:"campaignId"`
+	AdGroupEstimates  []AdGroupEstimate  `xml:"adGroupEstimates"`
+	PlatformEstimates []PlatformEstimate `xml:"platformEstimates"`
+}
+
+type AdGroupEstimate struct {
+	AdGroupId        int64             `xml:"adGroupId"`
+	KeywordEstimates []KeywordEstimate `xml:"keywordEstimates"`
+}
+
+// KeywordEstimate is the range of the stats expected for a keyword
+type KeywordEstimate struct {
+	CriterionId int64         `xml:"criterionId"`
+	Min         StatsEstimate `xml:"min"`
+	Max         StatsEstimate `xml:"max"`
+}
+
+// PlatformEstimate is the range of the stats expected for a campaign on a
+// platform (desktop, mobile or tablet)
+type PlatformEstimate struct {
+	Platform PlatformCriterion `xml:"platform"`
+	Min      StatsEstimate     `xml:"min"`
+	Max      StatsEstimate     `xml:"max"`
+}
+
+// AverageCpc: in micros
+// TotalCost: in micros, per day
+type StatsEstimate struct {
+	AverageCpc        int64   `xml:"averageCpc>microAmount"`
+	AveragePosition   float64 `xml:"averagePosition"`
+	ClickThroughRate  float64 `xml:"clickThroughRate"`
+	ClicksPerDay      float64 `xml:"clicksPerDay"`
+	ImpressionsPerDay float64 `xml:"impressionsPerDay"`
+	TotalCost         int64   `xml:"totalCost>microAmount"`
+}
+
+// Get returns the traffic estimates of the keywords of the selector
+//
+// Example
+//
+//   maxCpc := int64(1000000)
+//   result, err := trafficEstimatorService.Get(
+//     gads.TrafficEstimatorSelector{
+//       CampaignEstimateRequests: []gads.CampaignEstimateRequest{
+//         {
+//           Criteria: []gads.Criterion{
+//             gads.Location{Id: 2840},
+//             gads.LanguageCriterion{Id: 1000},
+//           },
+//           AdGroupEstimateRequests: []gads.AdGroupEstimateRequest{
+//             {
+//               MaxCpc: &maxCpc,
+//               KeywordEstimateRequests: []gads.KeywordEstimateRequest{
+//                 {Keyword: gads.KeywordCriterion{Text: "mars cruise", MatchType: "BROAD"}},
+//                 {Keyword: gads.KeywordCriterion{Text: "space hotel", MatchType: "EXACT"}},
+//               },
+//             },
+//           },
+//         },
+//       },
+//     },
+//   )
+//
+// Relevant documentation
+//
+//     https://developers.google.com/adwords/api/docs/reference/v201806/TrafficEstimatorService#get
+//
+func (s *TrafficEstimatorService) Get(selector TrafficEstimatorSelector) (result TrafficEstimatorResult, err error) {
+	selector.XMLName = xml.Name{"", "selector"}
+	respBody, err := s.Auth.request(
+		trafficEstimatorServiceUrl,
+		"get",
+		struct {
+			XMLName xml.Name
+			Sel     TrafficEstimatorSelector
+		}{
+			XMLName: xml.Name{
+				Space: s.Auth.namespace(trafficEstimatorServiceUrl),
+				Local: "get",
+			},
+			Sel: selector,
+		},
+	)
+	if err != nil {
+		return result, err
+	}
+	getResp := struct {
+		Result TrafficEstimatorResult `xml:"rval"`
+	}{}
+	err = xml.Unmarshal([]byte(respBody), &getResp)
+	if err != nil {
+		return result, err
+	}
+	return getResp.Result, err
+}
@@ -0,0 +1,122 @@
+package gads
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const testTrafficEstimatorResponse = `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Body>
+    <getResponse xmlns="https://adwords.google.com/api/adwords/o/v201806" xmlns:cm="https://adwords.google.com/api/adwords/cm/v201806">
+      <rval>
+        <campaignEstimates>
+          <adGroupEstimates>
+            <keywordEstimates>
+              <criterionId>0</criterionId>
+              <min>
+                <averageCpc><cm:microAmount>540000</cm:microAmount></averageCpc>
+                <averagePosition>1.8</averagePosition>
+                <clickThroughRate>0.021</clickThroughRate>
+                <clicksPerDay>3.5</clicksPerDay>
+                <impressionsPerDay>160.2</impressionsPerDay>
+                <totalCost><cm:microAmount>1890000</cm:microAmount></totalCost>
+              </min>
+              <max>
+                <averageCpc><cm:microAmount>660000</cm:microAmount></averageCpc>
+                <averagePosition>1.2</averagePosition>
+                <clickThroughRate>0.025</clickThroughRate>
+                <clicksPerDay>4.2</clicksPerDay>
+                <impressionsPerDay>170.8</impressionsPerDay>
+                <totalCost><cm:microAmount>2772000</cm:microAmount></totalCost>
+              </max>
+            </keywordEstimates>
+          </adGroupEstimates>
+          <platformEstimates>
+            <platform><cm:id>30001</cm:id><cm:type>PLATFORM</cm:type><cm:platformName>Desktop</cm:platformName></platform>
+            <min><clicksPerDay>1.5</clicksPerDay></min>
+            <max><clicksPerDay>2.5</clicksPerDay></max>
+          </platformEstimates>
+        </campaignEstimates>
+      </rval>
+    </getResponse>
+  </soap:Body>
+</soap:Envelope>`
+
+func TestTrafficEstimator(t *testing.T) {
+	var path, body string
+	auth, cleanup := testServerAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		path, body = r.URL.Path, string(b)
+		fmt.Fprint(w, testTrafficEstimatorResponse)
+	})
+	defer cleanup()
+
+	adGroupMaxCpc, keywordMaxCpc := int64(1000000), int64(2000000)
+	result, err := NewTrafficEstimatorService(&auth).Get(TrafficEstimatorSelector{
+		CampaignEstimateRequests: []CampaignEstimateRequest{
+			{
+				Criteria: []Criterion{
+					Location{Id: 2840},
+					LanguageCriterion{Id: 1000},
+				},
+				AdGroupEstimateRequests: []AdGroupEstimateRequest{
+					{
+						MaxCpc: &adGroupMaxCpc,
+						KeywordEstimateRequests: []KeywordEstimateRequest{
+							{Keyword: KeywordCriterion{Text: "mars cruise", MatchType: "BROAD"}, MaxCpc: &keywordMaxCpc},
+						},
+					},
+				},
+			},
+		},
+		PlatformEstimateRequested: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if path != "/o/v201806/TrafficEstimatorService" {
+		t.Errorf("unexpected request path %s", path)
+	}
+	for _, expected := range []string{
+		`<criteria xsi:type="Location">`,
+		`<criteria xsi:type="Language">`,
+		`<keyword xsi:type="Keyword">`,
+		`<maxCpc>`,
+		`<microAmount>2000000</microAmount>`,
+		`<platformEstimateRequested>true</platformEstimateRequested>`,
+	} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("expected %s in the request\n%s", expected, body)
+		}
+	}
+	if strings.Contains(body, "dailyBudget") || strings.Contains(body, "campaignId") {
+		t.Errorf("unexpected empty fields in the request\n%s", body)
+	}
+
+	if len(result.CampaignEstimates) != 1 || len(result.CampaignEstimates[0].AdGroupEstimates) != 1 {
+		t.Fatalf("unexpected result %#v", result)
+	}
+	keywords := result.CampaignEstimates[0].AdGroupEstimates[0].KeywordEstimates
+	if len(keywords) != 1 {
+		t.Fatalf("unexpected keyword estimates %#v", keywords)
+	}
+	expectedMin := StatsEstimate{
+		AverageCpc:        540000,
+		AveragePosition:   1.8,
+		ClickThroughRate:  0.021,
+		ClicksPerDay:      3.5,
+		ImpressionsPerDay: 160.2,
+		TotalCost:         1890000,
+	}
+	if keywords[0].Min != expectedMin || keywords[0].Max.TotalCost != 2772000 || keywords[0].Max.AveragePosition != 1.2 {
+		t.Errorf("unexpected keyword estimate %#v", keywords[0])
+	}
+	platforms := result.CampaignEstimates[0].PlatformEstimates
+	if len(platforms) != 1 || platforms[0].Platform.PlatformName != "Desktop" || platforms[0].Max.ClicksPerDay != 2.5 {
+		t.Errorf("unexpected platform estimates %#v", platforms)
+	}
+}